@@ -0,0 +1,19 @@
+data "hund_metric_definitions" "http" {
+  service = "http"
+}
+
+resource "hund_metric_provider" "api" {
+  watchdog = "656e624f8fbb65049112ea7f"
+
+  instances = {
+    for slug in data.hund_metric_definitions.http.services["http"].definition_slugs :
+    slug => { enabled = slug == "http.total_time" }
+  }
+
+  service = {
+    http = {
+      target  = "https://api.example.com/health"
+      regions = ["wa-us-1", "nj-us-1"]
+    }
+  }
+}
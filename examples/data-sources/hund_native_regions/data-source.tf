@@ -0,0 +1,16 @@
+data "hund_native_regions" "all" {
+}
+
+resource "hund_component" "api" {
+  group = "5f5f5f5f5f5f5f5f5f5f5f5f"
+  name  = "API"
+
+  watchdog = {
+    service = {
+      http = {
+        target  = "https://api.example.com/health"
+        regions = [for r in data.hund_native_regions.all.regions : r.slug if r.continent == "EU"]
+      }
+    }
+  }
+}
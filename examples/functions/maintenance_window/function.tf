@@ -0,0 +1,8 @@
+resource "hund_issue" "database_upgrade" {
+  title         = "Database Upgrade"
+  body          = "We will be upgrading our primary database cluster."
+  component_ids = [hund_component.api.id]
+
+  # A two hour window, announced to subscribers a day in advance.
+  schedule = provider::hund::maintenance_window("2024-06-01T04:00:00+02:00", "2h", "24h")
+}
@@ -0,0 +1,4 @@
+output "began_at" {
+  # "2024-06-01T02:00:00Z"
+  value = provider::hund::unix_to_rfc3339(1717207200)
+}
@@ -0,0 +1,13 @@
+# List every Component on the status page
+list "hund_component" "all" {
+  provider = hund
+}
+
+# List the Components of a single Group
+list "hund_component" "core_services" {
+  provider = hund
+
+  config {
+    group = "5d72d51f8fbb65b5d3a587e1"
+  }
+}
@@ -0,0 +1,3 @@
+list "hund_group" "all" {
+  provider = hund
+}
@@ -0,0 +1,9 @@
+# List the ongoing Issues of two Components
+list "hund_issue" "ongoing" {
+  provider = hund
+
+  config {
+    standing   = true
+    components = ["5d72d51f8fbb65b5d3a587e1", "5d72d51f8fbb65b5d3a587e2"]
+  }
+}
@@ -0,0 +1,7 @@
+list "hund_issue_template" "updates" {
+  provider = hund
+
+  config {
+    kind = "update"
+  }
+}
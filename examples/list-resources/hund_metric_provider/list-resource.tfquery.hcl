@@ -0,0 +1,9 @@
+# List the MetricProviders of a Watchdog, with their attributes
+list "hund_metric_provider" "api" {
+  provider         = hund
+  include_resource = true
+
+  config {
+    watchdog = "5d72d51f8fbb65b5d3a587e1"
+  }
+}
@@ -8,4 +8,7 @@ terraform {
 
 provider "hund" {
   domain = "example.hund.io"
+
+  # Check *_translations attributes against the locales of the status page.
+  locales = ["en", "de"]
 }
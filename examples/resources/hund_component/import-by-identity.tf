@@ -0,0 +1,6 @@
+import {
+  to = hund_component.example
+  identity = {
+    id = "5d72d51f8fbb65b5d3a587e1"
+  }
+}
@@ -0,0 +1,8 @@
+# Import a Component by ID
+terraform import hund_component.example 5d72d51f8fbb65b5d3a587e1
+
+# Import a Component by the name of its Group, and its own name
+terraform import hund_component.example "Core Services/API"
+
+# Escape forward slashes in the name of the Component with a backslash
+terraform import hund_component.example 'Core Services/TCP\/UDP'
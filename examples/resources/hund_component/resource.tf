@@ -28,3 +28,20 @@ resource "hund_component" "utr" {
     }
   }
 }
+
+resource "hund_group" "archive" {
+  name = "Archive"
+}
+
+# When destroyed, this Component is moved to the archive Group (and excluded
+# from global uptime and history), rather than being deleted.
+resource "hund_component" "legacy" {
+  name  = "Legacy Service"
+  group = hund_group.group.id
+
+  archive_instead = {
+    group = hund_group.archive.id
+  }
+
+  watchdog = { service = { manual = {} } }
+}
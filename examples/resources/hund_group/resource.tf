@@ -10,3 +10,13 @@ resource "hund_component" "components" {
 
   watchdog = { service = { manual = {} } }
 }
+
+resource "hund_group" "retired" {
+  name = "Retired Services"
+
+  # Move any Components remaining in this Group to another Group before it is
+  # destroyed.
+  on_destroy = {
+    move_components_to = hund_group.group.id
+  }
+}
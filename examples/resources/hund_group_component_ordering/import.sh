@@ -0,0 +1,5 @@
+# Import the ordering of a Group's Components by the Group's ID
+terraform import hund_group_component_ordering.example 5d72d51f8fbb65b5d3a587e1
+
+# Import the ordering of a Group's Components by the Group's name
+terraform import hund_group_component_ordering.example "Core Services"
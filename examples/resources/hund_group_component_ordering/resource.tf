@@ -28,3 +28,36 @@ resource "hund_component" "components" {
 
   watchdog = { service = { manual = {} } }
 }
+
+resource "hund_group" "shared" {
+  name = "Shared Group"
+}
+
+# Pin a single Component to the top of a Group shared with other modules,
+# leaving the remaining Components in their current order.
+resource "hund_group_component_ordering" "shared" {
+  group = resource.hund_group.shared.id
+  mode  = "prefix"
+
+  components = [resource.hund_component.status.id]
+}
+
+resource "hund_component" "status" {
+  name  = "Overall Status"
+  group = resource.hund_group.shared.id
+
+  watchdog = { service = { manual = {} } }
+}
+
+resource "hund_group" "alphabetical" {
+  name = "Alphabetical Group"
+}
+
+# Keep every Component in a Group sorted by name, without listing them.
+resource "hund_group_component_ordering" "alphabetical" {
+  group = resource.hund_group.alphabetical.id
+
+  order_by = {
+    attribute = "name"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = hund_group_ordering.example
+  identity = {
+    id = "group_ordering"
+  }
+}
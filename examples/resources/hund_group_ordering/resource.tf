@@ -0,0 +1,18 @@
+resource "hund_group" "infrastructure" {
+  name = "Infrastructure"
+}
+
+resource "hund_group" "applications" {
+  name = "Applications"
+}
+
+# Place these Groups at the top of the status page, leaving any other Groups
+# in their current order beneath them.
+resource "hund_group_ordering" "page" {
+  mode = "prefix"
+
+  groups = [
+    resource.hund_group.applications.id,
+    resource.hund_group.infrastructure.id,
+  ]
+}
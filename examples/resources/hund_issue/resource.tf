@@ -14,6 +14,9 @@ resource "hund_issue" "example" {
     ends_at   = timeadd("2023-09-23T13:00:00Z", "10h")
   }
 
+  # Or equivalently, with the maintenance_window function (Terraform 1.8+)
+  # schedule = provider::hund::maintenance_window("2023-09-23T15:00:00Z", "8h", null)
+
   # Retrospectively create an Issue
   # updates = [
   #   {
@@ -22,3 +25,19 @@ resource "hund_issue" "example" {
   #   }
   # ]
 }
+
+resource "hund_issue" "incident" {
+  # Post a final resolving Update when this resource is destroyed, leaving the
+  # Issue in the status page history.
+  resolve_on_destroy = {
+    body = "This incident has been resolved."
+  }
+
+  # Accept Updates posted by responders in the Hund UI, or by
+  # hund_issue_update resources, rather than warning about them as they appear.
+  adopt_external_updates = true
+
+  title         = "Elevated error rates"
+  body          = "We are investigating elevated error rates."
+  component_ids = ["5d72d51f8fbb65b5d3a587e1"]
+}
@@ -0,0 +1,5 @@
+# Import an IssueTemplate by ID
+terraform import hund_issue_template.example 5d72d51f8fbb65b5d3a587e1
+
+# Import an IssueTemplate by name
+terraform import hund_issue_template.example "Degraded Performance"
@@ -0,0 +1,7 @@
+import {
+  to = hund_issue_update.example
+  identity = {
+    id       = "5d72d51f8fbb65b5d3a587e2"
+    issue_id = "5d72d51f8fbb65b5d3a587e1"
+  }
+}
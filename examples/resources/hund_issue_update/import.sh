@@ -0,0 +1,2 @@
+# Import an Update using the ID of its Issue, and its own ID
+terraform import hund_issue_update.example 5d72d51f8fbb65b5d3a587e1/5d72d51f8fbb65b5d3a587e2
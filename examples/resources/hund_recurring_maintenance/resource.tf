@@ -0,0 +1,35 @@
+# Schedule a two hour maintenance window every Tuesday at 02:00 Berlin time,
+# keeping the next four occurrences on the status page.
+resource "hund_recurring_maintenance" "database" {
+  cron      = "0 2 * * TUE"
+  time_zone = "Europe/Berlin"
+
+  duration      = "2h"
+  notify_before = "24h"
+  horizon       = 4
+
+  component_ids = ["5d72d51f8fbb65b5d3a587e1"]
+
+  template = {
+    issue_template_id = "5d72d51f8fbb65b5d3a587e2"
+
+    variables = {
+      summary = { string = "Weekly database maintenance." }
+    }
+  }
+}
+
+# Or with an iCalendar recurrence rule: the first Sunday of every month,
+# beginning in March.
+resource "hund_recurring_maintenance" "network" {
+  rrule     = "FREQ=MONTHLY;BYDAY=1SU"
+  starts_at = "2025-03-02T04:00:00Z"
+
+  duration = "90m"
+
+  component_ids = ["5d72d51f8fbb65b5d3a587e1"]
+
+  template = {
+    issue_template_id = "5d72d51f8fbb65b5d3a587e3"
+  }
+}
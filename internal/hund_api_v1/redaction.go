@@ -0,0 +1,71 @@
+package hundApiV1
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// RedactedValue replaces secrets redacted from requests and responses.
+const RedactedValue = "***"
+
+// secretFields are the fields of request and response bodies which hold
+// secrets of Watchdog and Metric Provider services, at any depth. They
+// include every attribute marked Sensitive in the provider's schemas.
+var secretFields = map[string]bool{
+	"api_key":           true,
+	"api_token":         true,
+	"application_key":   true,
+	"monitor_api_key":   true,
+	"monitor_token":     true,
+	"password":          true,
+	"secret_access_key": true,
+	"webhook_key":       true,
+}
+
+// IsSecretField reports whether the field of a request or response body with
+// the given name holds a secret.
+func IsSecretField(name string) bool {
+	return secretFields[name]
+}
+
+// RedactBody returns the given JSON body, with the values of its secret
+// fields redacted. Bodies which are not JSON are returned as is.
+func RedactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if secretFields[key] && field != nil {
+				value[key] = RedactedValue
+			} else {
+				value[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactValue(element)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,392 @@
+package hundtest
+
+import (
+	"net/http"
+	"slices"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// renderComponent returns the response for a Component, whose group and
+// watchdog are unexpanded by default.
+func (s *Server) renderComponent(e expansions) func(object) object {
+	return func(component object) object {
+		result := copyObject(component)
+
+		if e.expanded("watchdog", false) {
+			result["watchdog"] = copyObject(s.watchdogs.objects[component["watchdog"].(string)])
+		}
+
+		if e.expanded("group", false) {
+			group := s.groups.objects[component["group"].(string)]
+			result["group"] = s.renderGroup(expansions{unexpand: []string{"components"}})(group)
+		}
+
+		return result
+	}
+}
+
+// findComponent returns the Component with the ID given in the request path,
+// writing a 404 response if there is none.
+func (s *Server) findComponent(w http.ResponseWriter, r *http.Request) (object, bool) {
+	id := r.PathValue("id")
+
+	component, ok := s.components.get(id)
+	if !ok {
+		writeNotFound(w, "Component", id)
+	}
+
+	return component, ok
+}
+
+// removeComponent deletes the Component, along with its Watchdog and Metric
+// Providers.
+func (s *Server) removeComponent(id string) {
+	component, ok := s.components.get(id)
+	if !ok {
+		return
+	}
+
+	watchdog := component["watchdog"].(string)
+
+	for _, provider := range s.metricProviders.filter(func(obj object) bool { return obj["watchdog"] == watchdog }) {
+		s.metricProviders.delete(provider["id"].(string))
+	}
+
+	s.watchdogs.delete(watchdog)
+
+	if group, ok := s.groups.get(component["group"].(string)); ok {
+		group["components"] = slices.DeleteFunc(group["components"].([]string), func(other string) bool { return other == id })
+	}
+
+	s.components.delete(id)
+}
+
+func (s *Server) getAllComponents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	components := s.components.filter(func(component object) bool {
+		if group := query.Get("group"); group != "" && component["group"] != group {
+			return false
+		}
+
+		if id := query.Get("issue"); id != "" {
+			issue, ok := s.issues.get(id)
+
+			return ok && slices.Contains(issue["components"].([]string), component["id"].(string))
+		}
+
+		return true
+	})
+
+	page(w, r, components, s.renderComponent(requestExpansions(r).within()))
+}
+
+func (s *Server) createComponent(w http.ResponseWriter, r *http.Request) {
+	var form hundApiV1.ComponentFormCreate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	if _, ok := fields["name"]; !ok {
+		writeInvalid(w, "name", "is required")
+		return
+	}
+
+	group, ok := s.groups.get(form.Group)
+	if !ok {
+		writeInvalid(w, "group", "must refer to an existing Group")
+		return
+	}
+
+	watchdogFields, _ := fields["watchdog"].(object)
+
+	watchdog, field, message := s.newWatchdog(watchdogFields)
+	if watchdog == nil {
+		writeInvalid(w, "watchdog/"+field, message)
+		return
+	}
+
+	component := s.newRecord("component")
+	component["description"] = nil
+	component["exclude_from_global_history"] = false
+	component["exclude_from_global_uptime"] = false
+	component["group"] = form.Group
+	component["last_event_at"] = nil
+	component["percent_uptime"] = 100.0
+	component["watchdog"] = watchdog["id"]
+
+	setI18n(component, fields, "name")
+	s.setRenderedI18n(component, fields, "description")
+	setField(component, fields, "exclude_from_global_history")
+	setField(component, fields, "exclude_from_global_uptime")
+
+	if _, ok := component["description_html"]; !ok {
+		component["description_html"] = s.html(nil)
+	}
+
+	s.components.put(component)
+	group["components"] = append(group["components"].([]string), component["id"].(string))
+
+	writeJSON(w, http.StatusCreated, s.renderComponent(requestExpansions(r))(component))
+}
+
+func (s *Server) retrieveComponent(w http.ResponseWriter, r *http.Request) {
+	component, ok := s.findComponent(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.renderComponent(requestExpansions(r))(component))
+}
+
+func (s *Server) updateComponent(w http.ResponseWriter, r *http.Request) {
+	component, ok := s.findComponent(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.ComponentFormUpdate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	group, ok := s.groups.get(component["group"].(string))
+
+	if form.Group != nil {
+		if group, ok = s.groups.get(*form.Group); !ok {
+			writeInvalid(w, "group", "must refer to an existing Group")
+			return
+		}
+	}
+
+	if watchdogFields, ok := fields["watchdog"].(object); ok {
+		watchdog := s.watchdogs.objects[component["watchdog"].(string)]
+
+		if field, message := s.applyWatchdogUpdate(watchdog, watchdogFields); field != "" {
+			writeInvalid(w, "watchdog/"+field, message)
+			return
+		}
+	}
+
+	if id := component["id"].(string); group["id"] != component["group"] {
+		if previous, ok := s.groups.get(component["group"].(string)); ok {
+			previous["components"] = slices.DeleteFunc(previous["components"].([]string), func(other string) bool { return other == id })
+		}
+
+		group["components"] = append(group["components"].([]string), id)
+		component["group"] = group["id"]
+	}
+
+	setI18n(component, fields, "name")
+	s.setRenderedI18n(component, fields, "description")
+	setField(component, fields, "exclude_from_global_history")
+	setField(component, fields, "exclude_from_global_uptime")
+
+	s.touch(component)
+
+	writeJSON(w, http.StatusOK, s.renderComponent(requestExpansions(r))(component))
+}
+
+func (s *Server) deleteComponent(w http.ResponseWriter, r *http.Request) {
+	component, ok := s.findComponent(w, r)
+	if !ok {
+		return
+	}
+
+	s.removeComponent(component["id"].(string))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newWatchdog creates a Watchdog, along with its Metric Providers, from the
+// given form. On failure, it returns the invalid field and the reason.
+func (s *Server) newWatchdog(fields object) (object, string, string) {
+	service, ok := fields["service"].(object)
+	if !ok {
+		return nil, "service", "is required"
+	}
+
+	if _, ok := service["type"].(string); !ok {
+		return nil, "service/type", "is required"
+	}
+
+	watchdog := s.newObject("watchdog")
+	watchdog["high_frequency"] = false
+	watchdog["latest_status"] = nil
+	watchdog["service"] = copyObject(service)
+
+	setField(watchdog, fields, "high_frequency")
+
+	s.watchdogs.put(watchdog)
+	s.createWatchdogMetricProviders(watchdog, fields)
+
+	return watchdog, "", ""
+}
+
+// createWatchdogMetricProviders creates the default Metric Provider of the
+// Watchdog, along with any other Metric Providers given in the form.
+func (s *Server) createWatchdogMetricProviders(watchdog object, fields object) {
+	service := watchdog["service"].(object)
+	defaultService := copyObject(service)
+
+	if service["type"] == "manual" {
+		defaultService = object{"type": "builtin"}
+	}
+
+	defaultFields, _ := fields["default_metric_provider"].(object)
+
+	s.metricProviders.put(s.newMetricProvider(watchdog["id"].(string), defaultService, defaultFields["instances"], true))
+
+	providers, _ := fields["metric_providers"].([]any)
+
+	for _, provider := range providers {
+		provider, ok := provider.(object)
+		if !ok {
+			continue
+		}
+
+		providerService, _ := provider["service"].(object)
+
+		s.metricProviders.put(s.newMetricProvider(watchdog["id"].(string), copyObject(providerService), provider["instances"], false))
+	}
+}
+
+// applyWatchdogUpdate applies the given form to the Watchdog. On failure, it
+// returns the invalid field and the reason.
+func (s *Server) applyWatchdogUpdate(watchdog object, fields object) (string, string) {
+	id := watchdog["id"].(string)
+	current := watchdog["service"].(object)
+
+	service, hasService := fields["service"].(object)
+
+	if typ, ok := service["type"]; hasService && ok && typ != current["type"] {
+		return "service/type", "cannot be changed; convert the Watchdog instead"
+	}
+
+	providers := []object{}
+	providerForms, _ := fields["metric_providers"].([]any)
+
+	for _, providerFields := range providerForms {
+		providerFields, _ := providerFields.(object)
+		providerId, _ := providerFields["id"].(string)
+
+		provider, ok := s.metricProviders.get(providerId)
+		if !ok || provider["watchdog"] != id {
+			return "metric_providers", "must refer to Metric Providers of this Watchdog"
+		}
+
+		providers = append(providers, provider)
+	}
+
+	if hasService {
+		watchdog["service"] = merge(current, service)
+	}
+
+	setField(watchdog, fields, "high_frequency")
+
+	if defaultFields, ok := fields["default_metric_provider"].(object); ok {
+		for _, provider := range s.metricProviders.filter(func(obj object) bool { return obj["watchdog"] == id && obj["default"] == true }) {
+			s.applyMetricProviderUpdate(provider, defaultFields)
+		}
+	}
+
+	for i, provider := range providers {
+		s.applyMetricProviderUpdate(provider, providerForms[i].(object))
+	}
+
+	return "", ""
+}
+
+// findWatchdog returns the Watchdog with the ID given in the request path,
+// writing a 404 response if there is none.
+func (s *Server) findWatchdog(w http.ResponseWriter, r *http.Request) (object, bool) {
+	id := r.PathValue("id")
+
+	watchdog, ok := s.watchdogs.get(id)
+	if !ok {
+		writeNotFound(w, "Watchdog", id)
+	}
+
+	return watchdog, ok
+}
+
+func (s *Server) retrieveWatchdog(w http.ResponseWriter, r *http.Request) {
+	watchdog, ok := s.findWatchdog(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, watchdog)
+}
+
+func (s *Server) updateWatchdog(w http.ResponseWriter, r *http.Request) {
+	watchdog, ok := s.findWatchdog(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.WatchdogFormUpdate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	if field, message := s.applyWatchdogUpdate(watchdog, fields); field != "" {
+		writeInvalid(w, field, message)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, watchdog)
+}
+
+// convertWatchdog replaces the service of the Watchdog, recreating its
+// Metric Providers.
+func (s *Server) convertWatchdog(w http.ResponseWriter, r *http.Request) {
+	watchdog, ok := s.findWatchdog(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.WatchdogFormConvert
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	service, ok := fields["service"].(object)
+	if !ok {
+		writeInvalid(w, "service", "is required")
+		return
+	}
+
+	if _, ok := service["type"].(string); !ok {
+		writeInvalid(w, "service/type", "is required")
+		return
+	}
+
+	id := watchdog["id"].(string)
+	keepDefault := form.KeepOriginalDefaultMetricProvider != nil && *form.KeepOriginalDefaultMetricProvider
+
+	for _, provider := range s.metricProviders.filter(func(obj object) bool { return obj["watchdog"] == id }) {
+		if keepDefault && provider["default"] == true {
+			provider["default"] = false
+			continue
+		}
+
+		s.metricProviders.delete(provider["id"].(string))
+	}
+
+	watchdog["service"] = copyObject(service)
+	setField(watchdog, fields, "high_frequency")
+
+	s.createWatchdogMetricProviders(watchdog, fields)
+
+	writeJSON(w, http.StatusOK, watchdog)
+}
@@ -0,0 +1,173 @@
+package hundtest
+
+import (
+	"net/http"
+	"slices"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// renderGroup returns the response for a Group, whose components are
+// expanded by default.
+func (s *Server) renderGroup(e expansions) func(object) object {
+	return func(group object) object {
+		result := copyObject(group)
+
+		result["position"] = slices.Index(s.groups.ids, group["id"].(string))
+
+		ids := group["components"].([]string)
+
+		if e.expanded("components", true) {
+			components := []object{}
+
+			for _, id := range ids {
+				components = append(components, s.components.objects[id])
+			}
+
+			result["components"] = pagedArray(components, false, len(components), s.renderComponent(expansions{}))
+		} else {
+			result["components"] = slices.Clone(ids)
+		}
+
+		return result
+	}
+}
+
+// findGroup returns the Group with the ID given in the request path, writing
+// a 404 response if there is none.
+func (s *Server) findGroup(w http.ResponseWriter, r *http.Request) (object, bool) {
+	id := r.PathValue("id")
+
+	group, ok := s.groups.get(id)
+	if !ok {
+		writeNotFound(w, "Group", id)
+	}
+
+	return group, ok
+}
+
+// moveGroup moves the Group to the given position, clamped to the bounds of
+// the list of Groups.
+func (s *Server) moveGroup(id string, position int) {
+	ids := slices.DeleteFunc(s.groups.ids, func(other string) bool { return other == id })
+	position = min(max(position, 0), len(ids))
+
+	s.groups.ids = slices.Insert(ids, position, id)
+}
+
+func (s *Server) getAllGroups(w http.ResponseWriter, r *http.Request) {
+	page(w, r, s.groups.all(), s.renderGroup(requestExpansions(r).within()))
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	var form hundApiV1.GroupFormCreate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	if _, ok := fields["name"]; !ok {
+		writeInvalid(w, "name", "is required")
+		return
+	}
+
+	group := s.newRecord("group")
+	group["collapsed"] = false
+	group["components"] = []string{}
+	group["description"] = nil
+
+	setI18n(group, fields, "name")
+	s.setRenderedI18n(group, fields, "description")
+	setField(group, fields, "collapsed")
+
+	if _, ok := group["description_html"]; !ok {
+		group["description_html"] = s.html(nil)
+	}
+
+	s.groups.put(group)
+
+	if form.Position != nil {
+		s.moveGroup(group["id"].(string), *form.Position)
+	}
+
+	writeJSON(w, http.StatusCreated, s.renderGroup(requestExpansions(r))(group))
+}
+
+func (s *Server) retrieveGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.findGroup(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.renderGroup(requestExpansions(r))(group))
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.findGroup(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.GroupFormUpdate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	setI18n(group, fields, "name")
+	s.setRenderedI18n(group, fields, "description")
+	setField(group, fields, "collapsed")
+
+	if form.Position != nil {
+		s.moveGroup(group["id"].(string), *form.Position)
+	}
+
+	s.touch(group)
+
+	writeJSON(w, http.StatusOK, s.renderGroup(requestExpansions(r))(group))
+}
+
+// deleteGroup deletes the Group, along with any Components it still
+// contains.
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.findGroup(w, r)
+	if !ok {
+		return
+	}
+
+	for _, id := range group["components"].([]string) {
+		s.removeComponent(id)
+	}
+
+	s.groups.delete(group["id"].(string))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) reorderGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.findGroup(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.ReorderAGroupsComponentsJSONBody
+
+	if _, ok := decodeFormOrError(w, r, &form); !ok {
+		return
+	}
+
+	current := slices.Sorted(slices.Values(group["components"].([]string)))
+	given := slices.Sorted(slices.Values(form))
+
+	if !slices.Equal(current, given) {
+		writeInvalid(w, "", "must list every Component of the Group exactly once")
+		return
+	}
+
+	group["components"] = slices.Clone(form)
+	s.touch(group)
+
+	writeJSON(w, http.StatusOK, s.renderGroup(requestExpansions(r))(group))
+}
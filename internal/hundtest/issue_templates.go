@@ -0,0 +1,245 @@
+package hundtest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// templateVariable matches a variable reference in an Issue Template.
+var templateVariable = regexp.MustCompile(`\{\{\s*vars\.([A-Za-z0-9_]+)\s*\}\}`)
+
+// renderTemplate substitutes the given variables into every translation of
+// the given translations object. This stands in for the full template
+// language of the Hund API.
+func renderTemplate(template any, variables object) any {
+	translations, ok := template.(object)
+	if !ok {
+		return template
+	}
+
+	result := object{}
+
+	for locale, translation := range translations {
+		text, ok := translation.(string)
+		if !ok || locale == "original" {
+			result[locale] = translation
+			continue
+		}
+
+		result[locale] = templateVariable.ReplaceAllStringFunc(text, func(reference string) string {
+			name := templateVariable.FindStringSubmatch(reference)[1]
+
+			value, ok := variables[name]
+			if !ok || value == nil {
+				return ""
+			}
+
+			if value, ok := value.(object); ok {
+				value, _ := i18n(value).(object)
+				return fmt.Sprint(value[locale])
+			}
+
+			return fmt.Sprint(value)
+		})
+	}
+
+	return result
+}
+
+// templateSchema returns the given variables form as a variables schema.
+func templateSchema(form any) object {
+	schema := object{}
+
+	variables, _ := form.(object)
+
+	for name, variable := range variables {
+		variable, _ := variable.(object)
+
+		normalized := object{"required": false, "type": "string"}
+		setField(normalized, variable, "required")
+		setField(normalized, variable, "type")
+
+		schema[name] = normalized
+	}
+
+	return schema
+}
+
+// findIssueTemplate returns the Issue Template with the ID given in the
+// request path, writing a 404 response if there is none.
+func (s *Server) findIssueTemplate(w http.ResponseWriter, r *http.Request) (object, bool) {
+	id := r.PathValue("id")
+
+	template, ok := s.issueTemplates.get(id)
+	if !ok {
+		writeNotFound(w, "Issue Template", id)
+	}
+
+	return template, ok
+}
+
+// applyIssueTemplateForm applies the given form to the Issue Template.
+func applyIssueTemplateForm(template object, fields object) {
+	setField(template, fields, "name")
+	setField(template, fields, "label")
+	setI18n(template, fields, "title")
+	setI18n(template, fields, "body")
+
+	if variables, ok := fields["variables"]; ok {
+		template["variables"] = templateSchema(variables)
+	}
+}
+
+func (s *Server) getAllIssueTemplates(w http.ResponseWriter, r *http.Request) {
+	kind := r.URL.Query().Get("kind")
+
+	templates := s.issueTemplates.filter(func(template object) bool {
+		return kind == "" || template["kind"] == kind
+	})
+
+	page(w, r, templates, copyObject)
+}
+
+func (s *Server) createIssueTemplate(w http.ResponseWriter, r *http.Request) {
+	var form hundApiV1.IssueTemplateFormCreate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	if form.Name == "" {
+		writeInvalid(w, "name", "is required")
+		return
+	}
+
+	if form.Kind != hundApiV1.ISSUETEMPLATEKINDIssue && form.Kind != hundApiV1.ISSUETEMPLATEKINDUpdate {
+		writeInvalid(w, "kind", "must be one of issue, update")
+		return
+	}
+
+	template := s.newRecord("issue_template")
+	template["body"] = nil
+	template["kind"] = string(form.Kind)
+	template["label"] = nil
+	template["title"] = nil
+	template["variables"] = object{}
+
+	applyIssueTemplateForm(template, fields)
+
+	s.issueTemplates.put(template)
+
+	writeJSON(w, http.StatusCreated, template)
+}
+
+func (s *Server) retrieveIssueTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := s.findIssueTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, template)
+}
+
+func (s *Server) updateIssueTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := s.findIssueTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.IssueTemplateFormUpdate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	applyIssueTemplateForm(template, fields)
+	s.touch(template)
+
+	writeJSON(w, http.StatusOK, template)
+}
+
+func (s *Server) deleteIssueTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := s.findIssueTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	s.issueTemplates.delete(template["id"].(string))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newTemplateApplication returns the application of an Issue Template of the
+// given kind, from the given form: either the ID of the Issue Template, or an
+// application form. On failure, it returns the reason.
+func (s *Server) newTemplateApplication(form any, kind string) (object, object, string) {
+	fields, ok := form.(object)
+	if !ok {
+		id, _ := form.(string)
+		fields = object{"issue_template": id}
+	}
+
+	id, _ := fields["issue_template"].(string)
+
+	template, ok := s.issueTemplates.get(id)
+	if !ok || template["kind"] != kind {
+		return nil, nil, "must refer to an existing Issue Template of kind " + kind
+	}
+
+	application := s.newObject("issue_template/application")
+	application["body"] = nil
+	application["issue_template"] = id
+	application["label"] = nil
+	application["schema"] = template["variables"]
+	application["variables"] = object{}
+
+	if kind == "issue" {
+		application["title"] = nil
+	}
+
+	s.applyTemplateApplicationForm(application, fields)
+
+	return application, template, ""
+}
+
+// applyTemplateApplicationForm applies the given form to an Issue Template
+// application.
+func (s *Server) applyTemplateApplicationForm(application object, fields object) {
+	setField(application, fields, "label")
+	setField(application, fields, "variables")
+	setI18n(application, fields, "body")
+
+	if _, ok := application["title"]; ok {
+		setI18n(application, fields, "title")
+	}
+
+	if schema, ok := fields["schema"]; ok {
+		application["schema"] = templateSchema(schema)
+	}
+
+	if sync, _ := fields["sync_with_issue_template"].(bool); sync {
+		if template, ok := s.issueTemplates.get(application["issue_template"].(string)); ok {
+			application["schema"] = template["variables"]
+		}
+	}
+}
+
+// renderApplication returns the value of the named field (e.g. "body") of
+// an object to which the Issue Template was applied: the application's
+// override, or the template's own, with the variables substituted.
+func renderApplication(application object, template object, name string) any {
+	source := application[name]
+
+	if source == nil {
+		source = template[name]
+	}
+
+	variables, _ := application["variables"].(object)
+
+	return renderTemplate(source, variables)
+}
@@ -0,0 +1,646 @@
+package hundtest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// renderIssue returns the response for an Issue, deriving its state from the
+// current time. Its components and updates are always expanded.
+func (s *Server) renderIssue(issue object) object {
+	result := copyObject(issue)
+	now := s.timestamp()
+
+	began, _ := toInt64(issue["began_at"])
+	ended, isEnded := toInt64(issue["ended_at"])
+
+	resolved := isEnded && ended <= now
+
+	result["resolved"] = resolved
+	result["standing"] = !resolved && began <= now
+	result["scheduled"] = issue["schedule"] != nil
+
+	if !isEnded || ended > now {
+		ended = now
+	}
+
+	result["duration"] = max(ended-began, 0)
+
+	switch {
+	case issue["schedule"] != nil:
+		result["specialization"] = "maintenance"
+	case issue["label"] == "informational":
+		result["specialization"] = "informational"
+	default:
+		result["specialization"] = "general"
+	}
+
+	if schedule, ok := issue["schedule"].(object); ok {
+		schedule = copyObject(schedule)
+
+		startsAt, _ := toInt64(schedule["starts_at"])
+		endsAt, _ := toInt64(schedule["ends_at"])
+		notifyAt, notify := toInt64(schedule["notify_subscribers_at"])
+
+		schedule["started"] = startsAt <= now
+		schedule["ended"] = endsAt <= now || resolved
+		schedule["notified"] = notify && notifyAt <= now
+
+		result["schedule"] = schedule
+	}
+
+	components := []object{}
+
+	for _, id := range issue["components"].([]string) {
+		if component, ok := s.components.get(id); ok {
+			components = append(components, component)
+		}
+	}
+
+	updates := s.issueUpdates(issue["id"].(string))
+
+	result["components"] = pagedArray(components, false, len(components), s.renderComponent(expansions{}))
+	result["updates"] = pagedArray(updates, false, len(updates), s.renderUpdate(expansions{}))
+
+	return result
+}
+
+// issueUpdates returns the Updates of the Issue, in order of creation.
+func (s *Server) issueUpdates(id string) []object {
+	return s.updates.filter(func(update object) bool { return update["issue"] == id })
+}
+
+// findIssue returns the Issue with the ID given in the request path, writing
+// a 404 response if there is none.
+func (s *Server) findIssue(w http.ResponseWriter, r *http.Request) (object, bool) {
+	id := r.PathValue("id")
+
+	issue, ok := s.issues.get(id)
+	if !ok {
+		writeNotFound(w, "Issue", id)
+	}
+
+	return issue, ok
+}
+
+func (s *Server) getAllIssues(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	components := query["components[]"]
+
+	if component := query.Get("component"); component != "" {
+		components = append(components, component)
+	}
+
+	issues := []object{}
+
+	for _, issue := range s.issues.all() {
+		rendered := s.renderIssue(issue)
+
+		if len(components) > 0 && !slices.ContainsFunc(issue["components"].([]string), func(id string) bool { return slices.Contains(components, id) }) {
+			continue
+		}
+
+		upcoming := rendered["scheduled"] == true && rendered["standing"] == false && rendered["resolved"] == false
+
+		filters := map[string]bool{
+			"standing": rendered["standing"] == true,
+			"upcoming": upcoming,
+			"resolved": rendered["resolved"] == true,
+		}
+
+		matches := true
+
+		for name, value := range filters {
+			if param := query.Get(name); param != "" && value != (param == "true") {
+				matches = false
+			}
+		}
+
+		if matches {
+			issues = append(issues, issue)
+		}
+	}
+
+	page(w, r, issues, s.renderIssue)
+}
+
+// buildIssue returns a new Issue from the given creation form, without
+// storing it. On failure, it returns the invalid field and the reason.
+func (s *Server) buildIssue(form hundApiV1.IssueFormCreate, fields object) (object, string, string) {
+	if len(form.Components) == 0 {
+		return nil, "components", "must contain at least one Component"
+	}
+
+	for _, id := range form.Components {
+		if _, ok := s.components.get(id); !ok {
+			return nil, "components", "must refer to existing Components"
+		}
+	}
+
+	issue := s.newRecord("issue")
+	issue["began_at"] = s.timestamp()
+	issue["body"] = i18n("")
+	issue["cancelled"] = nil
+	issue["cancelled_at"] = nil
+	issue["components"] = slices.Clone(form.Components)
+	issue["ended_at"] = nil
+	issue["label"] = nil
+	issue["open_graph_image_url"] = nil
+	issue["priority"] = 0
+	issue["retrospective"] = false
+	issue["schedule"] = nil
+	issue["state_override"] = nil
+	issue["template"] = nil
+
+	if value, ok := fields["template"]; ok && value != nil {
+		application, template, message := s.newTemplateApplication(value, "issue")
+		if application == nil {
+			return nil, "template", message
+		}
+
+		issue["template"] = application
+		issue["label"] = template["label"]
+	} else if _, ok := fields["title"]; !ok {
+		return nil, "title", "is required without a template"
+	}
+
+	for _, name := range []string{"began_at", "label", "open_graph_image_url", "priority", "state_override"} {
+		setField(issue, fields, name)
+	}
+
+	setI18n(issue, fields, "title")
+	setI18n(issue, fields, "body")
+
+	if _, ok := fields["ended_at"]; ok && form.EndedAt != nil {
+		issue["ended_at"] = *form.EndedAt
+		issue["retrospective"] = true
+	}
+
+	if form.Schedule != nil {
+		if form.Schedule.StartsAt >= form.Schedule.EndsAt {
+			return nil, "schedule/ends_at", "must be after starts_at"
+		}
+
+		schedule := s.newObject("schedule")
+		schedule["starts_at"] = form.Schedule.StartsAt
+		schedule["ends_at"] = form.Schedule.EndsAt
+		schedule["notify_subscribers_at"] = form.Schedule.NotifySubscribersAt
+
+		issue["schedule"] = schedule
+		issue["began_at"] = form.Schedule.StartsAt
+		issue["ended_at"] = form.Schedule.EndsAt
+	}
+
+	s.renderIssueTemplate(issue)
+
+	return issue, "", ""
+}
+
+// renderIssueTemplate derives the title and body of the Issue from its
+// Issue Template application, if any.
+func (s *Server) renderIssueTemplate(issue object) {
+	application, ok := issue["template"].(object)
+	if !ok {
+		return
+	}
+
+	template, ok := s.issueTemplates.get(application["issue_template"].(string))
+	if !ok {
+		return
+	}
+
+	issue["title"] = renderApplication(application, template, "title")
+	issue["body"] = renderApplication(application, template, "body")
+}
+
+func (s *Server) createIssue(w http.ResponseWriter, r *http.Request) {
+	var form hundApiV1.IssueFormCreate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	issue, field, message := s.buildIssue(form, fields)
+	if issue == nil {
+		writeInvalid(w, field, message)
+		return
+	}
+
+	issue["body_html"] = s.html(issue["body"])
+
+	updateForms, _ := fields["updates"].([]any)
+	updates := []object{}
+
+	for i, updateFields := range updateForms {
+		updateFields, _ := updateFields.(object)
+
+		update, message := s.buildUpdate(issue, updateFields)
+		if update == nil {
+			writeInvalid(w, "updates/"+strconv.Itoa(i)+"/template", message)
+			return
+		}
+
+		updates = append(updates, update)
+	}
+
+	s.issues.put(issue)
+
+	for _, update := range updates {
+		s.updates.put(update)
+	}
+
+	if len(updates) > 0 {
+		s.resolveIssue(issue)
+	}
+
+	writeJSON(w, http.StatusCreated, s.renderIssue(issue))
+}
+
+func (s *Server) previewIssue(w http.ResponseWriter, r *http.Request) {
+	var form hundApiV1.IssueFormCreate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	issue, field, message := s.buildIssue(form, fields)
+	if issue == nil {
+		writeInvalid(w, field, message)
+		return
+	}
+
+	issue["body_html"] = s.html(issue["body"])
+
+	writeJSON(w, http.StatusOK, s.renderIssue(issue))
+}
+
+func (s *Server) retrieveIssue(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.findIssue(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.renderIssue(issue))
+}
+
+func (s *Server) reviseIssue(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.findIssue(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.IssueFormUpdate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	if form.Components != nil {
+		for _, id := range *form.Components {
+			if _, ok := s.components.get(id); !ok {
+				writeInvalid(w, "components", "must refer to existing Components")
+				return
+			}
+		}
+
+		issue["components"] = slices.Clone(*form.Components)
+	}
+
+	if value, ok := fields["template"]; ok {
+		current, hasCurrent := issue["template"].(object)
+		update, isUpdate := value.(object)
+
+		switch {
+		case value == nil:
+			issue["template"] = nil
+		case hasCurrent && isUpdate && update["issue_template"] == nil:
+			s.applyTemplateApplicationForm(current, update)
+		default:
+			application, _, message := s.newTemplateApplication(value, "issue")
+			if application == nil {
+				writeInvalid(w, "template", message)
+				return
+			}
+
+			issue["template"] = application
+		}
+	}
+
+	if schedule, ok := issue["schedule"].(object); ok {
+		if scheduleFields, ok := fields["schedule"].(object); ok {
+			for _, name := range []string{"starts_at", "ends_at", "notify_subscribers_at"} {
+				setField(schedule, scheduleFields, name)
+			}
+
+			issue["began_at"] = schedule["starts_at"]
+			issue["ended_at"] = schedule["ends_at"]
+		}
+	}
+
+	for _, name := range []string{"began_at", "label", "open_graph_image_url", "priority", "state_override"} {
+		setField(issue, fields, name)
+	}
+
+	setI18n(issue, fields, "title")
+	setI18n(issue, fields, "body")
+
+	s.renderIssueTemplate(issue)
+
+	issue["body_html"] = s.html(issue["body"])
+	s.touch(issue)
+
+	writeJSON(w, http.StatusOK, s.renderIssue(issue))
+}
+
+func (s *Server) deleteIssue(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.findIssue(w, r)
+	if !ok {
+		return
+	}
+
+	id := issue["id"].(string)
+
+	for _, update := range s.issueUpdates(id) {
+		s.updates.delete(update["id"].(string))
+	}
+
+	s.issues.delete(id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// cancelIssue cancels a scheduled Issue, recording the cancellation with an
+// Update.
+func (s *Server) cancelIssue(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.findIssue(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.IssueFormCancel
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	if issue["schedule"] == nil {
+		writeInvalid(w, "", "only scheduled Issues can be cancelled")
+		return
+	}
+
+	if issue["cancelled"] == true {
+		writeInvalid(w, "", "the Issue is already cancelled")
+		return
+	}
+
+	updateFields := object{"label": "cancelled"}
+	setField(updateFields, fields, "body")
+	setField(updateFields, fields, "template")
+
+	update, message := s.buildUpdate(issue, updateFields)
+	if update == nil {
+		writeInvalid(w, "template", message)
+		return
+	}
+
+	now := s.timestamp()
+
+	issue["cancelled"] = true
+	issue["cancelled_at"] = now
+	issue["ended_at"] = now
+	s.touch(issue)
+
+	s.updates.put(update)
+
+	writeJSON(w, http.StatusOK, s.renderIssue(issue))
+}
+
+// renderUpdate returns the response for an Update, whose issue is
+// unexpanded by default.
+func (s *Server) renderUpdate(e expansions) func(object) object {
+	return func(update object) object {
+		result := copyObject(update)
+
+		effectiveAfter, _ := toInt64(update["effective_after"])
+		result["effective"] = effectiveAfter <= s.timestamp()
+
+		if e.expanded("issue", false) {
+			if issue, ok := s.issues.get(update["issue"].(string)); ok {
+				result["issue"] = s.renderIssue(issue)
+			}
+		}
+
+		return result
+	}
+}
+
+// findUpdate returns the Issue and Update with the IDs given in the request
+// path, writing a 404 response if there is none.
+func (s *Server) findUpdate(w http.ResponseWriter, r *http.Request) (object, object, bool) {
+	issue, ok := s.findIssue(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+
+	id := r.PathValue("update")
+
+	update, ok := s.updates.get(id)
+	if !ok || update["issue"] != issue["id"] {
+		writeNotFound(w, "Update", id)
+		return nil, nil, false
+	}
+
+	return issue, update, true
+}
+
+// buildUpdate returns a new Update of the Issue from the given creation
+// form, without storing it. On failure, it returns the reason.
+func (s *Server) buildUpdate(issue object, fields object) (object, string) {
+	update := s.newRecord("update")
+	update["body"] = nil
+	update["effective_after"] = s.timestamp()
+	update["issue"] = issue["id"]
+	update["label"] = nil
+	update["reopening"] = false
+	update["state_override"] = nil
+	update["template"] = nil
+
+	if value, ok := fields["template"]; ok && value != nil {
+		application, template, message := s.newTemplateApplication(value, "update")
+		if application == nil {
+			return nil, message
+		}
+
+		update["template"] = application
+		update["label"] = template["label"]
+		update["body"] = renderApplication(application, template, "body")
+	}
+
+	setField(update, fields, "effective_after")
+	setField(update, fields, "label")
+	setField(update, fields, "state_override")
+	setI18n(update, fields, "body")
+
+	update["body_html"] = s.html(update["body"])
+
+	return update, ""
+}
+
+// resolveIssue derives the end of an unscheduled Issue from its Updates: it
+// is resolved by its latest Update, if labelled "resolved". Retrospective
+// and scheduled Issues keep their given end.
+func (s *Server) resolveIssue(issue object) {
+	if issue["retrospective"] == true || issue["schedule"] != nil {
+		return
+	}
+
+	issue["ended_at"] = nil
+
+	updates := s.issueUpdates(issue["id"].(string))
+
+	if len(updates) > 0 && updates[len(updates)-1]["label"] == "resolved" {
+		issue["ended_at"] = updates[len(updates)-1]["effective_after"]
+	}
+
+	s.touch(issue)
+}
+
+func (s *Server) getAllUpdates(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.findIssue(w, r)
+	if !ok {
+		return
+	}
+
+	page(w, r, s.issueUpdates(issue["id"].(string)), s.renderUpdate(requestExpansions(r).within()))
+}
+
+func (s *Server) createUpdate(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.findIssue(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.UpdateFormCreate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	update, message := s.buildUpdate(issue, fields)
+	if update == nil {
+		writeInvalid(w, "template", message)
+		return
+	}
+
+	if ended, ok := toInt64(issue["ended_at"]); ok && ended <= s.timestamp() && update["label"] != "resolved" {
+		update["reopening"] = true
+	}
+
+	s.updates.put(update)
+	s.resolveIssue(issue)
+
+	writeJSON(w, http.StatusCreated, s.renderUpdate(requestExpansions(r))(update))
+}
+
+func (s *Server) previewUpdate(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.findIssue(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.UpdateFormCreate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	update, message := s.buildUpdate(issue, fields)
+	if update == nil {
+		writeInvalid(w, "template", message)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.renderUpdate(requestExpansions(r))(update))
+}
+
+func (s *Server) retrieveUpdate(w http.ResponseWriter, r *http.Request) {
+	_, update, ok := s.findUpdate(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.renderUpdate(requestExpansions(r))(update))
+}
+
+func (s *Server) reviseUpdate(w http.ResponseWriter, r *http.Request) {
+	issue, update, ok := s.findUpdate(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.UpdateFormUpdate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	if value, ok := fields["template"]; ok {
+		current, hasCurrent := update["template"].(object)
+		revision, isRevision := value.(object)
+
+		switch {
+		case value == nil:
+			update["template"] = nil
+		case hasCurrent && isRevision && revision["issue_template"] == nil:
+			s.applyTemplateApplicationForm(current, revision)
+		default:
+			application, _, message := s.newTemplateApplication(value, "update")
+			if application == nil {
+				writeInvalid(w, "template", message)
+				return
+			}
+
+			update["template"] = application
+		}
+	}
+
+	if application, ok := update["template"].(object); ok {
+		if template, ok := s.issueTemplates.get(application["issue_template"].(string)); ok {
+			update["body"] = renderApplication(application, template, "body")
+		}
+	}
+
+	setField(update, fields, "effective_after")
+	setField(update, fields, "label")
+	setField(update, fields, "state_override")
+	setI18n(update, fields, "body")
+
+	update["body_html"] = s.html(update["body"])
+	s.touch(update)
+	s.resolveIssue(issue)
+
+	writeJSON(w, http.StatusOK, s.renderUpdate(requestExpansions(r))(update))
+}
+
+func (s *Server) deleteUpdate(w http.ResponseWriter, r *http.Request) {
+	issue, update, ok := s.findUpdate(w, r)
+	if !ok {
+		return
+	}
+
+	s.updates.delete(update["id"].(string))
+	s.resolveIssue(issue)
+
+	w.WriteHeader(http.StatusNoContent)
+}
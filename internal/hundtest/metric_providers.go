@@ -0,0 +1,193 @@
+package hundtest
+
+import (
+	"net/http"
+	"slices"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// newMetricProvider returns a new Metric Provider of the Watchdog, with
+// instances created from the given forms.
+func (s *Server) newMetricProvider(watchdog string, service object, instances any, isDefault bool) object {
+	provider := s.newObject("metric_provider")
+	provider["default"] = isDefault
+	provider["instances"] = []object{}
+	provider["service"] = service
+	provider["watchdog"] = watchdog
+
+	s.applyMetricProviderUpdate(provider, object{"instances": instances})
+
+	return provider
+}
+
+// newMetricInstance returns a new Metric Instance of the given Metric
+// Definition.
+func (s *Server) newMetricInstance(slug string) object {
+	instance := s.newObject("metric_instance")
+	instance["aggregation"] = "average"
+	instance["definition_slug"] = slug
+	instance["enabled"] = true
+	instance["interpolation"] = "linear"
+	instance["plot_type"] = "line"
+	instance["slug"] = slug
+	instance["title"] = i18n(slug)
+	instance["top_level_enabled"] = false
+	instance["x_title"] = i18n("")
+	instance["x_type"] = "time"
+	instance["y_supremum"] = 0
+	instance["y_title"] = i18n("")
+	instance["y_type"] = "measure"
+
+	return instance
+}
+
+// applyMetricProviderUpdate applies the given form to the Metric Provider.
+// Instances are matched by their Metric Definition: unmatched instances are
+// created, and those marked as deleted are removed.
+func (s *Server) applyMetricProviderUpdate(provider object, fields object) {
+	if service, ok := fields["service"].(object); ok {
+		provider["service"] = merge(provider["service"].(object), service)
+	}
+
+	forms, _ := fields["instances"].([]any)
+	instances := provider["instances"].([]object)
+
+	for _, form := range forms {
+		form, ok := form.(object)
+		if !ok {
+			continue
+		}
+
+		slug, _ := form["definition_slug"].(string)
+
+		index := slices.IndexFunc(instances, func(instance object) bool { return instance["definition_slug"] == slug })
+
+		if deleted, _ := form["deleted"].(bool); deleted {
+			if index >= 0 {
+				instances = slices.Delete(instances, index, index+1)
+			}
+
+			continue
+		}
+
+		if index < 0 {
+			instances = append(instances, s.newMetricInstance(slug))
+			index = len(instances) - 1
+		}
+
+		instance := instances[index]
+
+		for _, name := range []string{"aggregation", "enabled", "plot_type", "top_level_enabled", "x_type", "y_supremum", "y_type"} {
+			setField(instance, form, name)
+		}
+
+		for _, name := range []string{"title", "x_title", "y_title"} {
+			setI18n(instance, form, name)
+		}
+	}
+
+	provider["instances"] = instances
+}
+
+// findMetricProvider returns the Metric Provider with the ID given in the
+// request path, writing a 404 response if there is none.
+func (s *Server) findMetricProvider(w http.ResponseWriter, r *http.Request) (object, bool) {
+	id := r.PathValue("id")
+
+	provider, ok := s.metricProviders.get(id)
+	if !ok {
+		writeNotFound(w, "Metric Provider", id)
+	}
+
+	return provider, ok
+}
+
+func (s *Server) getAllMetricProviders(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	providers := s.metricProviders.filter(func(provider object) bool {
+		if watchdog := query.Get("watchdog"); watchdog != "" && provider["watchdog"] != watchdog {
+			return false
+		}
+
+		if isDefault := query.Get("default"); isDefault != "" && provider["default"] != (isDefault == "true") {
+			return false
+		}
+
+		return true
+	})
+
+	page(w, r, providers, copyObject)
+}
+
+func (s *Server) createMetricProvider(w http.ResponseWriter, r *http.Request) {
+	var form hundApiV1.MetricProviderFormCreate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	if _, ok := s.watchdogs.get(form.Watchdog); !ok {
+		writeInvalid(w, "watchdog", "must refer to an existing Watchdog")
+		return
+	}
+
+	service, ok := fields["service"].(object)
+	if !ok {
+		writeInvalid(w, "service", "is required")
+		return
+	}
+
+	provider := s.newMetricProvider(form.Watchdog, copyObject(service), fields["instances"], false)
+
+	s.metricProviders.put(provider)
+
+	writeJSON(w, http.StatusCreated, provider)
+}
+
+func (s *Server) retrieveMetricProvider(w http.ResponseWriter, r *http.Request) {
+	provider, ok := s.findMetricProvider(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, provider)
+}
+
+func (s *Server) updateMetricProvider(w http.ResponseWriter, r *http.Request) {
+	provider, ok := s.findMetricProvider(w, r)
+	if !ok {
+		return
+	}
+
+	var form hundApiV1.MetricProviderFormUpdate
+
+	fields, ok := decodeFormOrError(w, r, &form)
+	if !ok {
+		return
+	}
+
+	s.applyMetricProviderUpdate(provider, fields)
+
+	writeJSON(w, http.StatusOK, provider)
+}
+
+// deleteMetricProvider deletes the Metric Provider, unless it is the default
+// Metric Provider of its Watchdog.
+func (s *Server) deleteMetricProvider(w http.ResponseWriter, r *http.Request) {
+	provider, ok := s.findMetricProvider(w, r)
+	if !ok {
+		return
+	}
+
+	if provider["default"] == true {
+		writeInvalid(w, "default", "the default Metric Provider of a Watchdog cannot be deleted")
+		return
+	}
+
+	s.metricProviders.delete(provider["id"].(string))
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,295 @@
+package hundtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// Mode selects whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the loaded fixture, without any
+	// network access.
+	ModeReplay Mode = iota
+
+	// ModeRecord passes requests through to the Hund API, recording each
+	// interaction into the loaded fixture.
+	ModeRecord
+)
+
+// redactedHeaders are the request headers left out of fixtures, as they
+// carry credentials.
+var redactedHeaders = []string{"Authorization", "Cookie"}
+
+// recordedHeaders are the response headers kept in fixtures.
+var recordedHeaders = []string{"Content-Type", "Location"}
+
+// Interaction is a request to the Hund API, along with its response, as
+// recorded in a fixture. Requests are recorded without their host, so that
+// fixtures can be replayed against any endpoint, and without credentials,
+// including the secret fields of their bodies. Responses are recorded as is,
+// to be replayed faithfully.
+type Interaction struct {
+	Request struct {
+		Method string      `json:"method"`
+		URL    string      `json:"url"`
+		Header http.Header `json:"header,omitempty"`
+		Body   string      `json:"body,omitempty"`
+	} `json:"request"`
+
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       string      `json:"body,omitempty"`
+	} `json:"response"`
+
+	// replayed is set once the interaction has been replayed.
+	replayed bool
+}
+
+// Fixture is a recorded sequence of interactions with the Hund API.
+type Fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper which records interactions with the Hund
+// API into fixture files, and replays them later, so that tests can cover the
+// real shapes of Hund API responses without network access.
+type Recorder struct {
+	// Mode is whether the Recorder records or replays.
+	Mode Mode
+
+	// Transport performs the requests being recorded. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	mu      sync.Mutex
+	path    string
+	fixture *Fixture
+}
+
+// NewRecorder returns a new Recorder in the given mode. A fixture must be
+// loaded before any requests are made.
+func NewRecorder(mode Mode) *Recorder {
+	return &Recorder{Mode: mode}
+}
+
+// Load starts using the fixture at the given path. When replaying, the
+// fixture is read from the path, failing with an error satisfying
+// errors.Is(err, fs.ErrNotExist) if it was never recorded; when recording,
+// the fixture starts out empty.
+func (r *Recorder) Load(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fixture := &Fixture{}
+
+	if r.Mode == ModeReplay {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if err := json.Unmarshal(contents, fixture); err != nil {
+			return fmt.Errorf("reading fixture %s: %w", path, err)
+		}
+	}
+
+	r.path = path
+	r.fixture = fixture
+
+	return nil
+}
+
+// Save writes the recorded fixture to the path it was loaded from. It does
+// nothing when replaying.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Mode != ModeRecord || r.fixture == nil {
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(r.fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(contents, '\n'), 0o644)
+}
+
+// Unreplayed returns the number of interactions in the fixture which were not
+// replayed.
+func (r *Recorder) Unreplayed() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+
+	if r.fixture != nil {
+		for _, interaction := range r.fixture.Interactions {
+			if !interaction.replayed {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.fixture == nil {
+		return nil, errors.New("hundtest: no fixture loaded")
+	}
+
+	if r.Mode == ModeRecord {
+		return r.record(req, body)
+	}
+
+	return r.replay(req, body), nil
+}
+
+// record performs the request, recording the interaction.
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	rsp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rspBody, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp.Body = io.NopCloser(bytes.NewReader(rspBody))
+
+	interaction := &Interaction{}
+	interaction.Request.Method = req.Method
+	interaction.Request.URL = req.URL.RequestURI()
+	interaction.Request.Header = req.Header.Clone()
+	interaction.Request.Body = hundApiV1.RedactBody(body)
+	interaction.Response.StatusCode = rsp.StatusCode
+	interaction.Response.Header = http.Header{}
+	interaction.Response.Body = string(rspBody)
+
+	for _, name := range redactedHeaders {
+		interaction.Request.Header.Del(name)
+	}
+
+	for _, name := range recordedHeaders {
+		if value := rsp.Header.Values(name); len(value) > 0 {
+			interaction.Response.Header[name] = value
+		}
+	}
+
+	r.fixture.Interactions = append(r.fixture.Interactions, interaction)
+
+	return rsp, nil
+}
+
+// replay responds with the first interaction not yet replayed with the same
+// method and URL, preferring one with the same body, once redacted. Terraform may perform
+// requests concurrently, so interactions are not required to be replayed in
+// the order they were recorded. Requests matching no interaction receive a
+// 501 response, which is not retried.
+func (r *Recorder) replay(req *http.Request, body []byte) *http.Response {
+	var match *Interaction
+
+	redacted := hundApiV1.RedactBody(body)
+
+	for _, interaction := range r.fixture.Interactions {
+		if interaction.replayed || interaction.Request.Method != req.Method || interaction.Request.URL != req.URL.RequestURI() {
+			continue
+		}
+
+		if interaction.Request.Body == redacted {
+			match = interaction
+			break
+		}
+
+		if match == nil {
+			match = interaction
+		}
+	}
+
+	rsp := &http.Response{
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Request:    req,
+	}
+
+	if match == nil {
+		message, _ := json.Marshal(map[string]string{
+			"logref":  "501",
+			"message": "hundtest: no recorded interaction for " + req.Method + " " + req.URL.RequestURI() + " in " + r.path,
+		})
+
+		rsp.StatusCode = http.StatusNotImplemented
+		rsp.Header.Set("Content-Type", "application/hal+json")
+		rsp.Body = io.NopCloser(bytes.NewReader(message))
+	} else {
+		match.replayed = true
+
+		rsp.StatusCode = match.Response.StatusCode
+		rsp.Header = match.Response.Header.Clone()
+		rsp.Body = io.NopCloser(bytes.NewReader([]byte(match.Response.Body)))
+	}
+
+	rsp.Status = fmt.Sprintf("%d %s", rsp.StatusCode, http.StatusText(rsp.StatusCode))
+
+	if rsp.Header == nil {
+		rsp.Header = http.Header{}
+	}
+
+	return rsp
+}
+
+// readBody reads the body of the request, leaving it in place to be sent.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
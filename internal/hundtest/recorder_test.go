@@ -0,0 +1,185 @@
+package hundtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+func testRecorderClient(t *testing.T, endpoint string, recorder *Recorder) *hundApiV1.Client {
+	security, err := hundApiV1.WithSecurity("secret-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := hundApiV1.NewClient(endpoint, security, hundApiV1.WithHTTPClient(&http.Client{Transport: recorder}), hundApiV1.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "*")
+
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestRecorder(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "fixtures", "TestRecorder.json")
+
+	recorder := NewRecorder(ModeRecord)
+	if err := recorder.Load(path); err != nil {
+		t.Fatal(err)
+	}
+
+	client := testRecorderClient(t, s.Endpoint(), recorder)
+
+	first := testCreateGroup(t, client, "First")
+	second := testCreateGroup(t, client, "Second")
+
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(contents), "secret-key") || strings.Contains(string(contents), s.URL) {
+		t.Errorf("expected the fixture to leave out credentials and the host, got %s", contents)
+	}
+
+	s.Close()
+
+	replayer := NewRecorder(ModeReplay)
+	if err := replayer.Load(path); err != nil {
+		t.Fatal(err)
+	}
+
+	client = testRecorderClient(t, "https://elsewhere.invalid/api/v1", replayer)
+
+	// Requests are matched by body, not only in the order of recording.
+	replayedSecond := testCreateGroup(t, client, "Second")
+	replayedFirst := testCreateGroup(t, client, "First")
+
+	if replayedFirst.Id != first.Id || replayedSecond.Id != second.Id {
+		t.Errorf("expected the recorded groups to be replayed, got %s and %s", replayedFirst.Id, replayedSecond.Id)
+	}
+
+	if unreplayed := replayer.Unreplayed(); unreplayed != 0 {
+		t.Errorf("expected every interaction to be replayed, %d were not", unreplayed)
+	}
+
+	rsp, err := client.RetrieveAGroup(ctx, first.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("expected an unrecorded request to be answered with 501, got %d", rsp.StatusCode)
+	}
+
+	if err := NewRecorder(ModeReplay).Load(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected loading a missing fixture to fail with fs.ErrNotExist, got %v", err)
+	}
+}
+
+func TestRecorderRedactsBodies(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "fixtures", "TestRecorderRedactsBodies.json")
+
+	recorder := NewRecorder(ModeRecord)
+	if err := recorder.Load(path); err != nil {
+		t.Fatal(err)
+	}
+
+	client := testRecorderClient(t, s.Endpoint(), recorder)
+
+	group := testCreateGroup(t, client, "Group")
+
+	createComponent := func(client *hundApiV1.Client, name string, key string) hundApiV1.ComponentExpansionary {
+		service := hundApiV1.FormWatchdogCreate{}
+
+		if err := service.FromFormWatchdogCreate1(hundApiV1.UpdownFormCreate{
+			MonitorApiKey: key,
+			MonitorToken:  "token",
+			Type:          hundApiV1.UpdownFormCreateTypeUpdown,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		rsp, err := client.CreateAComponent(ctx, hundApiV1.ComponentFormCreate{
+			Group:    group.Id,
+			Name:     testI18nString(t, name),
+			Watchdog: hundApiV1.WatchdogFormCreate{Service: service},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		component, err := hundApiV1.ParseCreateAComponentResponse(rsp)
+		if err != nil || component.StatusCode() != 201 {
+			t.Fatalf("expected 201 creating a component, got %v: %s", err, component.Body)
+		}
+
+		return *component.HALJSON201
+	}
+
+	first := createComponent(client, "First", "first-secret")
+	second := createComponent(client, "Second", "second-secret")
+
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	var fixture Fixture
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal(contents, &fixture); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, interaction := range fixture.Interactions {
+		if strings.Contains(interaction.Request.Body, "-secret") {
+			t.Errorf("expected secrets to be redacted from request bodies, got %s", interaction.Request.Body)
+		}
+	}
+
+	replayer := NewRecorder(ModeReplay)
+	if err := replayer.Load(path); err != nil {
+		t.Fatal(err)
+	}
+
+	client = testRecorderClient(t, "https://elsewhere.invalid/api/v1", replayer)
+
+	// Redacted requests are still matched by the rest of their body.
+	testCreateGroup(t, client, "Group")
+
+	replayedSecond := createComponent(client, "Second", "other-secret")
+	replayedFirst := createComponent(client, "First", "other-secret")
+
+	if replayedFirst.Id != first.Id || replayedSecond.Id != second.Id {
+		t.Errorf("expected the recorded components to be replayed, got %s and %s", replayedFirst.Id, replayedSecond.Id)
+	}
+}
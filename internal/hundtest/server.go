@@ -0,0 +1,571 @@
+// Package hundtest provides an in-process fake of the Hund API, for hermetic
+// acceptance testing of the provider.
+//
+// The fake keeps a single status page in memory, and implements the Groups,
+// Components, Watchdogs, Metric Providers, Issues, Updates, and Issue
+// Templates endpoints of the Hund API closely enough for the provider: forms
+// are decoded with the hundApiV1 types, lists are paged, and `expand[]` and
+// `unexpand[]` are honored for the fields the Hund API expands. It is not a
+// complete model of the Hund API; notably, Issue Templates are rendered by
+// plain variable substitution, and Watchdogs never report any status.
+//
+// For coverage of the real Hund API without network access, the package also
+// provides a Recorder, which records interactions with the Hund API into
+// fixture files and replays them.
+package hundtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// DefaultLocale is the default language of the fake status page.
+const DefaultLocale = "en"
+
+// defaultLimit and maxLimit bound the size of each page of a paged array.
+const (
+	defaultLimit = 50
+	maxLimit     = 100
+)
+
+// object is a Hund API object, as it is encoded in JSON.
+type object = map[string]any
+
+// Server is a fake Hund API, serving a single status page from memory.
+type Server struct {
+	*httptest.Server
+
+	// Now returns the current time of the status page. It may be replaced
+	// before making any requests, to control the time seen by the fake.
+	Now func() time.Time
+
+	// RenderMarkdown returns the HTML rendering of markdown fields. It may be
+	// replaced to render differently from the provider, whose predictions
+	// would otherwise always match.
+	RenderMarkdown func(source string) string
+
+	mu     sync.Mutex
+	nextId int
+
+	groups          *collection
+	components      *collection
+	watchdogs       *collection
+	metricProviders *collection
+	issueTemplates  *collection
+	issues          *collection
+	updates         *collection
+}
+
+// NewServer starts and returns a new fake Hund API, with an empty status
+// page. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		Now:            time.Now,
+		RenderMarkdown: renderMarkdown,
+
+		groups:          newCollection(),
+		components:      newCollection(),
+		watchdogs:       newCollection(),
+		metricProviders: newCollection(),
+		issueTemplates:  newCollection(),
+		issues:          newCollection(),
+		updates:         newCollection(),
+	}
+
+	s.Server = httptest.NewServer(s.handler())
+
+	return s
+}
+
+// Endpoint returns the base URL of the fake Hund API, suitable for the
+// `endpoint` of the provider.
+func (s *Server) Endpoint() string {
+	return s.URL + "/api/v1"
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	routes := map[string]func(http.ResponseWriter, *http.Request){
+		"GET /groups":                          s.getAllGroups,
+		"POST /groups":                         s.createGroup,
+		"GET /groups/{id}":                     s.retrieveGroup,
+		"PUT /groups/{id}":                     s.updateGroup,
+		"DELETE /groups/{id}":                  s.deleteGroup,
+		"PUT /groups/{id}/reorder":             s.reorderGroup,
+		"GET /components":                      s.getAllComponents,
+		"POST /components":                     s.createComponent,
+		"GET /components/{id}":                 s.retrieveComponent,
+		"PUT /components/{id}":                 s.updateComponent,
+		"DELETE /components/{id}":              s.deleteComponent,
+		"GET /watchdogs/{id}":                  s.retrieveWatchdog,
+		"PUT /watchdogs/{id}":                  s.updateWatchdog,
+		"PUT /watchdogs/{id}/convert":          s.convertWatchdog,
+		"GET /metric_providers":                s.getAllMetricProviders,
+		"POST /metric_providers":               s.createMetricProvider,
+		"GET /metric_providers/{id}":           s.retrieveMetricProvider,
+		"PUT /metric_providers/{id}":           s.updateMetricProvider,
+		"DELETE /metric_providers/{id}":        s.deleteMetricProvider,
+		"GET /issue_templates":                 s.getAllIssueTemplates,
+		"POST /issue_templates":                s.createIssueTemplate,
+		"GET /issue_templates/{id}":            s.retrieveIssueTemplate,
+		"PUT /issue_templates/{id}":            s.updateIssueTemplate,
+		"DELETE /issue_templates/{id}":         s.deleteIssueTemplate,
+		"GET /issues":                          s.getAllIssues,
+		"POST /issues":                         s.createIssue,
+		"POST /issues/preview":                 s.previewIssue,
+		"GET /issues/{id}":                     s.retrieveIssue,
+		"PUT /issues/{id}":                     s.reviseIssue,
+		"DELETE /issues/{id}":                  s.deleteIssue,
+		"PUT /issues/{id}/cancel":              s.cancelIssue,
+		"GET /issues/{id}/updates":             s.getAllUpdates,
+		"POST /issues/{id}/updates":            s.createUpdate,
+		"POST /issues/{id}/updates/preview":    s.previewUpdate,
+		"GET /issues/{id}/updates/{update}":    s.retrieveUpdate,
+		"PUT /issues/{id}/updates/{update}":    s.reviseUpdate,
+		"DELETE /issues/{id}/updates/{update}": s.deleteUpdate,
+	}
+
+	for pattern, handler := range routes {
+		method, route, _ := strings.Cut(pattern, " ")
+
+		mux.HandleFunc(method+" /api/v1"+route, handler)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "No route matches "+r.Method+" "+r.URL.Path)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			writeError(w, http.StatusUnauthorized, "An API key must be given as a Bearer token")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// collection is an ordered set of objects, keyed by ID.
+type collection struct {
+	ids     []string
+	objects map[string]object
+}
+
+func newCollection() *collection {
+	return &collection{objects: map[string]object{}}
+}
+
+func (c *collection) get(id string) (object, bool) {
+	obj, ok := c.objects[id]
+
+	return obj, ok
+}
+
+func (c *collection) put(obj object) {
+	id := obj["id"].(string)
+
+	if _, ok := c.objects[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+
+	c.objects[id] = obj
+}
+
+func (c *collection) delete(id string) {
+	delete(c.objects, id)
+	c.ids = slices.DeleteFunc(c.ids, func(other string) bool { return other == id })
+}
+
+// all returns every object in the collection, in order of creation.
+func (c *collection) all() []object {
+	result := make([]object, 0, len(c.ids))
+
+	for _, id := range c.ids {
+		result = append(result, c.objects[id])
+	}
+
+	return result
+}
+
+// filter returns every object in the collection satisfying the predicate, in
+// order of creation.
+func (c *collection) filter(predicate func(object) bool) []object {
+	return slices.DeleteFunc(c.all(), func(obj object) bool { return !predicate(obj) })
+}
+
+// newId returns a new, unique ObjectId.
+func (s *Server) newId() string {
+	s.nextId++
+
+	return fmt.Sprintf("%024x", s.nextId)
+}
+
+// timestamp returns the current time of the status page, as a Hund API
+// timestamp.
+func (s *Server) timestamp() int64 {
+	return s.Now().Unix()
+}
+
+// newObject returns a new object of the given type, with a fresh ID.
+func (s *Server) newObject(typ string) object {
+	return object{
+		"type": typ,
+		"id":   s.newId(),
+	}
+}
+
+// newRecord returns a new object of the given type, with a fresh ID and
+// creation timestamps.
+func (s *Server) newRecord(typ string) object {
+	obj := s.newObject(typ)
+
+	obj["created_at"] = s.timestamp()
+	obj["updated_at"] = obj["created_at"]
+
+	return obj
+}
+
+// touch records a change to the given object.
+func (s *Server) touch(obj object) {
+	obj["updated_at"] = s.timestamp()
+}
+
+// decodeForm decodes the JSON body of the request into both the given
+// hundApiV1 form, validating its shape, and a generic object, from which the
+// fields actually given can be read. The object is empty unless the body is a
+// JSON object.
+func decodeForm(r *http.Request, form any) (object, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, form); err != nil {
+		return nil, err
+	}
+
+	var value any
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	fields, ok := value.(object)
+	if !ok {
+		fields = object{}
+	}
+
+	return fields, nil
+}
+
+// decodeFormOrError is decodeForm, writing a 400 response on failure.
+func decodeFormOrError(w http.ResponseWriter, r *http.Request, form any) (object, bool) {
+	fields, err := decodeForm(r, form)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Malformed request body: "+err.Error())
+		return nil, false
+	}
+
+	return fields, true
+}
+
+// writeJSON writes the given value as a HAL+JSON response.
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// writeError writes a Hund API error response.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{
+		"logref":  strconv.Itoa(status),
+		"message": message,
+	})
+}
+
+// writeNotFound writes the 404 response for a missing object of the given
+// kind.
+func writeNotFound(w http.ResponseWriter, kind string, id string) {
+	writeError(w, http.StatusNotFound, kind+" "+id+" not found")
+}
+
+// writeInvalid writes the 422 response for a form referring to the given
+// field incorrectly.
+func writeInvalid(w http.ResponseWriter, field string, message string) {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+
+	_ = json.NewEncoder(w).Encode(object{
+		"logref":  "422",
+		"message": "Validation failed",
+		"_embedded": object{
+			"errors": []object{{"message": message, "path": "/" + field}},
+		},
+	})
+}
+
+// page writes the given objects as a paged array, honoring the `limit`,
+// `starting_after`, and `ending_before` query parameters.
+func page(w http.ResponseWriter, r *http.Request, objects []object, render func(object) object) {
+	query := r.URL.Query()
+
+	limit := defaultLimit
+
+	if param := query.Get("limit"); param != "" {
+		parsed, err := strconv.Atoi(param)
+		if err != nil || parsed < 1 || parsed > maxLimit {
+			writeError(w, http.StatusBadRequest, "limit must be an integer from 1 to "+strconv.Itoa(maxLimit))
+			return
+		}
+
+		limit = parsed
+	}
+
+	start, end := 0, len(objects)
+
+	indexOf := func(id string) int {
+		return slices.IndexFunc(objects, func(obj object) bool { return obj["id"] == id })
+	}
+
+	if after := query.Get("starting_after"); after != "" {
+		start = indexOf(after) + 1
+	} else if before := query.Get("ending_before"); before != "" {
+		if end = indexOf(before); end < 0 {
+			end = 0
+		}
+
+		start = max(end-limit, 0)
+	}
+
+	end = min(end, start+limit)
+
+	writeJSON(w, http.StatusOK, pagedArray(objects[start:end], end < len(objects), len(objects), render))
+}
+
+// pagedArray returns the given objects as a paged array.
+func pagedArray(objects []object, hasMore bool, total int, render func(object) object) object {
+	data := []object{}
+
+	for _, obj := range objects {
+		data = append(data, render(obj))
+	}
+
+	return object{
+		"type":        "paged_array",
+		"data":        data,
+		"has_more":    hasMore,
+		"total_count": total,
+	}
+}
+
+// expansions records which fields of a response are to be expanded.
+type expansions struct {
+	expand   []string
+	unexpand []string
+}
+
+func requestExpansions(r *http.Request) expansions {
+	query := r.URL.Query()
+
+	return expansions{expand: query["expand[]"], unexpand: query["unexpand[]"]}
+}
+
+// expanded reports whether the named field is expanded, given whether it is
+// expanded by default. Fields of the objects in a paged array are named with
+// a "data." prefix.
+func (e expansions) expanded(field string, byDefault bool) bool {
+	if slices.Contains(e.expand, field) {
+		return true
+	}
+
+	if slices.Contains(e.unexpand, field) {
+		return false
+	}
+
+	return byDefault
+}
+
+// within returns the expansions of the objects in a paged array.
+func (e expansions) within() expansions {
+	trim := func(fields []string) []string {
+		result := []string{}
+
+		for _, field := range fields {
+			if rest, ok := strings.CutPrefix(field, "data."); ok {
+				result = append(result, rest)
+			}
+		}
+
+		return result
+	}
+
+	return expansions{expand: trim(e.expand), unexpand: trim(e.unexpand)}
+}
+
+// markdownRenderer renders the markdown fields of the status page.
+var markdownRenderer = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+)
+
+// i18n returns the given I18nString form value as a full translations
+// object, as returned by the Hund API given `Accept-Language: *`.
+func i18n(value any) any {
+	switch value := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return object{"original": DefaultLocale, DefaultLocale: value}
+	case map[string]any:
+		result := object{}
+
+		for locale, translation := range value {
+			result[locale] = translation
+		}
+
+		if _, ok := result["original"]; !ok {
+			result["original"] = DefaultLocale
+		}
+
+		return result
+	default:
+		return value
+	}
+}
+
+// renderMarkdown renders markdown as the Hund API does.
+func renderMarkdown(source string) string {
+	var buf bytes.Buffer
+
+	if err := markdownRenderer.Convert([]byte(source), &buf); err != nil {
+		return source
+	}
+
+	return buf.String()
+}
+
+// html returns the HTML rendering of the given translations object.
+func (s *Server) html(value any) any {
+	translations, ok := value.(object)
+	if !ok {
+		return object{"original": DefaultLocale, DefaultLocale: ""}
+	}
+
+	result := object{}
+
+	for locale, translation := range translations {
+		if locale == "original" {
+			result[locale] = translation
+			continue
+		}
+
+		source, _ := translation.(string)
+
+		result[locale] = s.RenderMarkdown(source)
+	}
+
+	return result
+}
+
+// setI18n sets the named I18nString field of the object from the form, if
+// given.
+func setI18n(obj object, fields object, name string) {
+	if value, ok := fields[name]; ok {
+		obj[name] = i18n(value)
+	}
+}
+
+// setRenderedI18n sets the named I18nString field of the object from the
+// form, if given, along with its HTML rendering.
+func (s *Server) setRenderedI18n(obj object, fields object, name string) {
+	if _, ok := fields[name]; !ok {
+		return
+	}
+
+	setI18n(obj, fields, name)
+
+	obj[name+"_html"] = s.html(obj[name])
+}
+
+// setField sets the named field of the object from the form, if given.
+func setField(obj object, fields object, name string) {
+	if value, ok := fields[name]; ok {
+		obj[name] = value
+	}
+}
+
+// merge returns a copy of the base object, with the fields of the given
+// object merged over it.
+func merge(base object, fields object) object {
+	result := object{}
+
+	for name, value := range base {
+		result[name] = value
+	}
+
+	for name, value := range fields {
+		result[name] = value
+	}
+
+	return result
+}
+
+// copyObject returns a shallow copy of the given object, for rendering.
+func copyObject(obj object) object {
+	return merge(obj, nil)
+}
+
+// toInt64 converts a decoded JSON number into an integer.
+func toInt64(value any) (int64, bool) {
+	switch value := value.(type) {
+	case json.Number:
+		parsed, err := value.Int64()
+		return parsed, err == nil
+	case int64:
+		return value, true
+	case int:
+		return int64(value), true
+	case float64:
+		return int64(value), true
+	default:
+		return 0, false
+	}
+}
+
+// stringsOf converts a decoded JSON array into a list of strings.
+func stringsOf(value any) []string {
+	result := []string{}
+
+	switch value := value.(type) {
+	case []string:
+		result = append(result, value...)
+	case []any:
+		for _, v := range value {
+			if str, ok := v.(string); ok {
+				result = append(result, str)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,420 @@
+package hundtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+func testClient(t *testing.T, s *Server) *hundApiV1.Client {
+	security, err := hundApiV1.WithSecurity("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := hundApiV1.NewClient(s.Endpoint(), security, hundApiV1.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "*")
+
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func testI18nString(t *testing.T, value string) hundApiV1.I18nString {
+	result := hundApiV1.I18nString{}
+
+	if err := result.FromI18nString0(value); err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
+
+func testTranslation(t *testing.T, value hundApiV1.I18nString) string {
+	translations, err := value.AsI18nString1()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return translations[translations["original"]]
+}
+
+func testCreateGroup(t *testing.T, client *hundApiV1.Client, name string) hundApiV1.Group {
+	rsp, err := client.CreateAGroup(context.Background(), hundApiV1.GroupFormCreate{
+		Name:        testI18nString(t, name),
+		Description: hundApiV1.DblPtr(hundApiV1.Ptr(testI18nString(t, "**"+name+"**"))),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := hundApiV1.ParseCreateAGroupResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group.StatusCode() != 201 {
+		t.Fatalf("expected 201 creating a group, got %d: %s", group.StatusCode(), group.Body)
+	}
+
+	return *group.HALJSON201
+}
+
+func testCreateComponent(t *testing.T, client *hundApiV1.Client, group string, name string) hundApiV1.ComponentExpansionary {
+	service := hundApiV1.FormWatchdogCreate{}
+
+	if err := service.FromFormWatchdogCreate0(hundApiV1.ManualFormCreate{State: 1, Type: hundApiV1.ManualFormCreateTypeManual}); err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := client.CreateAComponent(context.Background(), hundApiV1.ComponentFormCreate{
+		Group:    group,
+		Name:     testI18nString(t, name),
+		Watchdog: hundApiV1.WatchdogFormCreate{Service: service},
+	}, hundApiV1.Expand("watchdog"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	component, err := hundApiV1.ParseCreateAComponentResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if component.StatusCode() != 201 {
+		t.Fatalf("expected 201 creating a component, got %d: %s", component.StatusCode(), component.Body)
+	}
+
+	return *component.HALJSON201
+}
+
+func TestServerGroups(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	client := testClient(t, s)
+	ctx := context.Background()
+
+	first := testCreateGroup(t, client, "First")
+	second := testCreateGroup(t, client, "Second")
+
+	if first.Position != 0 || second.Position != 1 {
+		t.Errorf("expected positions 0 and 1, got %d and %d", first.Position, second.Position)
+	}
+
+	if html := testTranslation(t, first.DescriptionHtml); html != "<p><strong>First</strong></p>\n" {
+		t.Errorf("expected the description to be rendered, got %q", html)
+	}
+
+	rsp, err := client.UpdateAGroup(ctx, second.Id, hundApiV1.GroupFormUpdate{Position: hundApiV1.Ptr(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := hundApiV1.ParseUpdateAGroupResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.StatusCode() != 200 || updated.HALJSON200.Position != 0 {
+		t.Errorf("expected the group to move to position 0, got %s", updated.Body)
+	}
+
+	rsp, err = client.GetAllGroups(ctx, &hundApiV1.GetAllGroupsParams{Limit: hundApiV1.Ptr(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	groups, err := hundApiV1.ParseGetAllGroupsResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data := groups.HALJSON200.Data; len(data) != 1 || data[0].Id != second.Id || !groups.HALJSON200.HasMore || groups.HALJSON200.TotalCount != 2 {
+		t.Fatalf("expected the first page to hold only the second group, got %s", groups.Body)
+	}
+
+	rsp, err = client.GetAllGroups(ctx, &hundApiV1.GetAllGroupsParams{StartingAfter: hundApiV1.Ptr(second.Id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	groups, err = hundApiV1.ParseGetAllGroupsResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data := groups.HALJSON200.Data; len(data) != 1 || data[0].Id != first.Id || groups.HALJSON200.HasMore {
+		t.Fatalf("expected the next page to hold only the first group, got %s", groups.Body)
+	}
+
+	rsp, err = client.DeleteAGroup(ctx, first.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp.StatusCode != 204 {
+		t.Errorf("expected 204 deleting a group, got %d", rsp.StatusCode)
+	}
+
+	rsp, err = client.RetrieveAGroup(ctx, first.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp.StatusCode != 404 {
+		t.Errorf("expected 404 retrieving a deleted group, got %d", rsp.StatusCode)
+	}
+}
+
+func TestServerComponents(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	client := testClient(t, s)
+	ctx := context.Background()
+
+	group := testCreateGroup(t, client, "Group")
+	first := testCreateComponent(t, client, group.Id, "First")
+	second := testCreateComponent(t, client, group.Id, "Second")
+
+	watchdog, err := first.Watchdog.AsComponentExpansionaryWatchdog1()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if service, err := watchdog.Service.AsServicesWatchdog0(); err != nil || service.State != 1 {
+		t.Errorf("expected a manual watchdog, got %v (%v)", service, err)
+	}
+
+	rsp, err := client.ReorderAGroupsComponents(ctx, group.Id, []string{second.Id, first.Id}, hundApiV1.Unexpand("components"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reordered, err := hundApiV1.ParseReorderAGroupsComponentsResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	components, err := reordered.HALJSON200.Components.AsGroupComponents1()
+	if err != nil || len(components) != 2 || components[0] != second.Id {
+		t.Errorf("expected the components to be reordered, got %s", reordered.Body)
+	}
+
+	rsp, err = client.GetAllComponents(ctx, &hundApiV1.GetAllComponentsParams{Group: hundApiV1.Ptr(group.Id)}, hundApiV1.Expand("data.watchdog"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listed, err := hundApiV1.ParseGetAllComponentsResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(listed.HALJSON200.Data) != 2 {
+		t.Fatalf("expected two components, got %s", listed.Body)
+	}
+
+	if _, err := listed.HALJSON200.Data[0].Watchdog.AsComponentExpansionaryWatchdog1(); err != nil {
+		t.Errorf("expected expanded watchdogs, got %s", listed.Body)
+	}
+
+	rsp, err = client.GetAllMetricProviders(ctx, &hundApiV1.GetAllMetricProvidersParams{Watchdog: hundApiV1.Ptr(watchdog.Id)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providers, err := hundApiV1.ParseGetAllMetricProvidersResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data := providers.HALJSON200.Data; len(data) != 1 || !data[0].Default || data[0].Service.Discriminator() != "builtin" {
+		t.Errorf("expected a builtin default metric provider, got %s", providers.Body)
+	}
+
+	rsp, err = client.DeleteAComponent(ctx, first.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp.StatusCode != 204 {
+		t.Errorf("expected 204 deleting a component, got %d", rsp.StatusCode)
+	}
+
+	rsp, err = client.RetrieveAWatchdog(ctx, watchdog.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp.StatusCode != 404 {
+		t.Errorf("expected the watchdog to be deleted with its component, got %d", rsp.StatusCode)
+	}
+}
+
+func TestServerIssues(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	now := time.Unix(1700000000, 0)
+	s.Now = func() time.Time { return now }
+
+	client := testClient(t, s)
+	ctx := context.Background()
+
+	group := testCreateGroup(t, client, "Group")
+	component := testCreateComponent(t, client, group.Id, "Component")
+
+	rsp, err := client.CreateAIssueTemplate(ctx, hundApiV1.IssueTemplateFormCreate{
+		Name:  "Outage",
+		Kind:  hundApiV1.ISSUETEMPLATEKINDIssue,
+		Title: hundApiV1.Ptr(testI18nString(t, "{{ vars.service }} is down")),
+		Body:  hundApiV1.Ptr(testI18nString(t, "We are looking into **{{vars.service}}**.")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template, err := hundApiV1.ParseCreateAIssueTemplateResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if template.StatusCode() != 201 {
+		t.Fatalf("expected 201 creating a template, got %d: %s", template.StatusCode(), template.Body)
+	}
+
+	application := hundApiV1.IssueFormCreate_Template{}
+
+	err = application.FromIssueFormCreateTemplate1(hundApiV1.IssueTemplateApplicationIssueFormCreate{
+		IssueTemplate: template.HALJSON201.Id,
+		Variables: &hundApiV1.IssueTemplateVariablesApplication{
+			"service": testTemplateVariable(t, "API"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err = client.CreateAIssue(ctx, hundApiV1.IssueFormCreate{
+		Components: []string{component.Id},
+		Template:   &application,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := hundApiV1.ParseCreateAIssueResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created.StatusCode() != 201 {
+		t.Fatalf("expected 201 creating an issue, got %d: %s", created.StatusCode(), created.Body)
+	}
+
+	issue := created.HALJSON201
+
+	if title := testTranslation(t, issue.Title); title != "API is down" {
+		t.Errorf("expected the template title to be rendered, got %q", title)
+	}
+
+	if body := testTranslation(t, issue.BodyHtml); body != "<p>We are looking into <strong>API</strong>.</p>\n" {
+		t.Errorf("expected the template body to be rendered, got %q", body)
+	}
+
+	if !issue.Standing || issue.Resolved || len(issue.Components.Data) != 1 {
+		t.Errorf("expected a standing issue of one component, got %s", created.Body)
+	}
+
+	now = now.Add(time.Hour)
+
+	rsp, err = client.CreateAUpdate(ctx, issue.Id, hundApiV1.UpdateFormCreate{
+		Body:  hundApiV1.DblPtr(hundApiV1.Ptr(testI18nString(t, "Fixed."))),
+		Label: hundApiV1.DblPtr(hundApiV1.Ptr(hundApiV1.UPDATELABELResolved)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp.StatusCode != 201 {
+		t.Fatalf("expected 201 creating an update, got %d", rsp.StatusCode)
+	}
+
+	rsp, err = client.GetAllIssues(ctx, &hundApiV1.GetAllIssuesParams{Resolved: hundApiV1.Ptr(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := hundApiV1.ParseGetAllIssuesResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data := issues.HALJSON200.Data; len(data) != 1 || !data[0].Resolved || data[0].Duration != 3600 || len(data[0].Updates.Data) != 1 {
+		t.Fatalf("expected the issue to be resolved by its update, got %s", issues.Body)
+	}
+
+	rsp, err = client.CreateAIssue(ctx, hundApiV1.IssueFormCreate{
+		Components: []string{component.Id},
+		Title:      hundApiV1.Ptr(testI18nString(t, "Maintenance")),
+		Schedule: &hundApiV1.ScheduleFormCreate{
+			StartsAt: now.Add(time.Hour).Unix(),
+			EndsAt:   now.Add(2 * time.Hour).Unix(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scheduled, err := hundApiV1.ParseCreateAIssueResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if scheduled.StatusCode() != 201 || !scheduled.HALJSON201.Scheduled || scheduled.HALJSON201.Standing {
+		t.Fatalf("expected an upcoming scheduled issue, got %s", scheduled.Body)
+	}
+
+	rsp, err = client.CancelAScheduledIssue(ctx, scheduled.HALJSON201.Id, hundApiV1.IssueFormCancel{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cancelled, err := hundApiV1.ParseCancelAScheduledIssueResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cancelled.StatusCode() != 200 || cancelled.HALJSON200.Cancelled == nil || !*cancelled.HALJSON200.Cancelled {
+		t.Errorf("expected the issue to be cancelled, got %s", cancelled.Body)
+	}
+
+	rsp, err = client.CreateAIssue(ctx, hundApiV1.IssueFormCreate{Components: []string{"missing"}, Title: hundApiV1.Ptr(testI18nString(t, "Nope"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp.StatusCode != 422 {
+		t.Errorf("expected 422 creating an issue of a missing component, got %d", rsp.StatusCode)
+	}
+}
+
+func testTemplateVariable(t *testing.T, value string) hundApiV1.IssueTemplateVariableApplication {
+	result := hundApiV1.IssueTemplateVariableApplication{}
+
+	if err := result.FromIssueTemplateVariableApplication2(value); err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
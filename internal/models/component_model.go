@@ -25,6 +25,13 @@ type ComponentModel struct {
 	LastEventAt                 types.String   `tfsdk:"last_event_at"`
 	PercentUptime               types.Float64  `tfsdk:"percent_uptime"`
 	Watchdog                    *WatchdogModel `tfsdk:"watchdog"`
+
+	DeletionProtection types.Bool             `tfsdk:"deletion_protection"`
+	ArchiveInstead     *ComponentArchiveModel `tfsdk:"archive_instead"`
+}
+
+type ComponentArchiveModel struct {
+	Group types.String `tfsdk:"group"`
 }
 
 func ToComponentModel(ctx context.Context, comp hundApiV1.ComponentExpansionary) (ComponentModel, diag.Diagnostics) {
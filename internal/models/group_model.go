@@ -1,6 +1,8 @@
 package models
 
 import (
+	"slices"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,6 +22,14 @@ type GroupModel struct {
 	Collapsed                   types.Bool   `tfsdk:"collapsed"`
 	Position                    types.Int64  `tfsdk:"position"`
 	Components                  types.List   `tfsdk:"components"`
+
+	OnDestroy *GroupOnDestroyModel `tfsdk:"on_destroy"`
+}
+
+type GroupOnDestroyModel struct {
+	FailIfNotEmpty   types.Bool   `tfsdk:"fail_if_not_empty"`
+	MoveComponentsTo types.String `tfsdk:"move_components_to"`
+	DeleteComponents types.Bool   `tfsdk:"delete_components"`
 }
 
 func ToGroupModel(group hundApiV1.Group) (GroupModel, diag.Diagnostics) {
@@ -87,8 +97,17 @@ func ToGroupModel(group hundApiV1.Group) (GroupModel, diag.Diagnostics) {
 type GroupComponentOrderingModel struct {
 	Id    types.String `tfsdk:"id"`
 	Group types.String `tfsdk:"group"`
+	Mode  types.String `tfsdk:"mode"`
 
-	Components []types.String `tfsdk:"components"`
+	Components []types.String                   `tfsdk:"components"`
+	OrderBy    *GroupComponentOrderingRuleModel `tfsdk:"order_by"`
+}
+
+type GroupComponentOrderingRuleModel struct {
+	Attribute  types.String `tfsdk:"attribute"`
+	Locale     types.String `tfsdk:"locale"`
+	Descending types.Bool   `tfsdk:"descending"`
+	Weights    types.Map    `tfsdk:"weights"`
 }
 
 func ToGroupComponentOrderingModel(group hundApiV1.Group) (GroupComponentOrderingModel, diag.Diagnostics) {
@@ -114,3 +133,28 @@ func ToGroupComponentOrderingModel(group hundApiV1.Group) (GroupComponentOrderin
 
 	return model, diags
 }
+
+type GroupOrderingModel struct {
+	Id   types.String `tfsdk:"id"`
+	Mode types.String `tfsdk:"mode"`
+
+	Groups []types.String `tfsdk:"groups"`
+}
+
+func ToGroupOrderingModel(groups []hundApiV1.Group) GroupOrderingModel {
+	sorted := slices.Clone(groups)
+
+	slices.SortStableFunc(sorted, func(a, b hundApiV1.Group) int {
+		return a.Position - b.Position
+	})
+
+	model := GroupOrderingModel{
+		Groups: []types.String{},
+	}
+
+	for _, group := range sorted {
+		model.Groups = append(model.Groups, types.StringValue(group.Id))
+	}
+
+	return model
+}
@@ -40,7 +40,20 @@ type IssueModel struct {
 
 	Template *IssueTemplateApplicationIssueModel `tfsdk:"template"`
 
-	ArchiveOnDestroy types.Bool `tfsdk:"archive_on_destroy"`
+	ArchiveOnDestroy     types.Bool                  `tfsdk:"archive_on_destroy"`
+	ResolveOnDestroy     *IssueResolveOnDestroyModel `tfsdk:"resolve_on_destroy"`
+	AdoptExternalUpdates types.Bool                  `tfsdk:"adopt_external_updates"`
+}
+
+type IssueResolveOnDestroyModel struct {
+	Body             types.String                        `tfsdk:"body"`
+	BodyTranslations types.Map                           `tfsdk:"body_translations"`
+	Template         *IssueResolveOnDestroyTemplateModel `tfsdk:"template"`
+}
+
+type IssueResolveOnDestroyTemplateModel struct {
+	IssueTemplateId types.String                           `tfsdk:"issue_template_id"`
+	Variables       IssueTemplateVariablesApplicationModel `tfsdk:"variables"`
 }
 
 func ToIssueModel(ctx context.Context, issue hundApiV1.Issue) (IssueModel, diag.Diagnostics) {
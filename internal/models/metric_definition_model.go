@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MetricDefinitionServiceModel struct {
+	DefinitionSlugs []types.String `tfsdk:"definition_slugs"`
+	CustomInstances types.Bool     `tfsdk:"custom_instances"`
+}
+
+func ToMetricDefinitionServiceModels() map[string]MetricDefinitionServiceModel {
+	models := map[string]MetricDefinitionServiceModel{}
+
+	for serviceType, slugs := range MetricProviderServiceInstances() {
+		model := MetricDefinitionServiceModel{
+			DefinitionSlugs: []types.String{},
+			CustomInstances: types.BoolValue(slugs == nil),
+		}
+
+		for _, slug := range slugs {
+			model.DefinitionSlugs = append(model.DefinitionSlugs, types.StringValue(slug))
+		}
+
+		models[serviceType] = model
+	}
+
+	return models
+}
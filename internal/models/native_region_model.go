@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+type NativeRegionDetailModel struct {
+	Slug      types.String `tfsdk:"slug"`
+	City      types.String `tfsdk:"city"`
+	Country   types.String `tfsdk:"country"`
+	Continent types.String `tfsdk:"continent"`
+}
+
+type nativeRegionDetail struct {
+	region    hundApiV1.NATIVEREGION
+	city      string
+	country   string
+	continent string
+}
+
+var nativeRegionDetails = []nativeRegionDetail{
+	{hundApiV1.AmsNl1, "Amsterdam", "NL", "EU"},
+	{hundApiV1.FraDe1, "Frankfurt", "DE", "EU"},
+	{hundApiV1.HelFi1, "Helsinki", "FI", "EU"},
+	{hundApiV1.LonGb1, "London", "GB", "EU"},
+	{hundApiV1.NjUs1, "New Jersey", "US", "NA"},
+	{hundApiV1.ParFr1, "Paris", "FR", "EU"},
+	{hundApiV1.SinSg1, "Singapore", "SG", "AS"},
+	{hundApiV1.SydAu1, "Sydney", "AU", "OC"},
+	{hundApiV1.TxUs1, "Dallas", "US", "NA"},
+	{hundApiV1.WaUs1, "Seattle", "US", "NA"},
+}
+
+func NativeRegionDetails() []NativeRegionDetailModel {
+	models := []NativeRegionDetailModel{}
+
+	for _, d := range nativeRegionDetails {
+		models = append(models, NativeRegionDetailModel{
+			Slug:      types.StringValue(string(d.region)),
+			City:      types.StringValue(d.city),
+			Country:   types.StringValue(d.country),
+			Continent: types.StringValue(d.continent),
+		})
+	}
+
+	return models
+}
@@ -92,7 +92,7 @@ func ToNativeServiceModel(native hundApiV1.Native) (NativeServiceModel, diag.Dia
 		return &NativeDnsServiceModel{
 			RecordType:           types.StringValue(string(dns.RecordType)),
 			Nameservers:          nameserversModel,
-			ResponseContainment:  types.StringValue(string(dns.ResponseContainment)),
+			ResponseContainment:  nativeDnsResponseContainment(dns.ResponseContainment),
 			ResponsesMustContain: assertionsModel,
 
 			Target:                            types.StringValue(dns.Target),
@@ -232,6 +232,16 @@ func (s NativeIcmpServiceModel) ApiUpdateForm() hundApiV1.ICMPFormUpdate {
 	}
 }
 
+// nativeDnsResponseContainment treats an empty containment as unset, as it
+// cannot be configured.
+func nativeDnsResponseContainment(containment hundApiV1.NATIVEDNSRESPONSECONTAINMENT) types.String {
+	if containment == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(containment))
+}
+
 type NativeHttpHeadersModel map[string]types.String
 
 func ToNativeHttpHeadersModel(headers hundApiV1.HTTPHeaders) NativeHttpHeadersModel {
@@ -126,12 +126,14 @@ func ToWatchdogServiceModel(service hundApiV1.ServicesWatchdog) (WatchdogService
 }
 
 func (s *WatchdogServiceModel) ReplaceSensitiveAttributes(orig WatchdogServiceModel) {
-	if s.Pingdom != nil {
+	if s.Pingdom != nil && orig.Pingdom != nil {
 		s.Pingdom.ApiToken = orig.Pingdom.ApiToken
-	} else if s.Updown != nil {
+	} else if s.Updown != nil && orig.Updown != nil {
 		s.Updown.MonitorApiKey = orig.Updown.MonitorApiKey
-	} else if s.Uptimerobot != nil {
+	} else if s.Uptimerobot != nil && orig.Uptimerobot != nil {
 		s.Uptimerobot.MonitorApiKey = orig.Uptimerobot.MonitorApiKey
+	} else if s.NativeHttp != nil && orig.NativeHttp != nil {
+		s.NativeHttp.Password = orig.NativeHttp.Password
 	}
 }
 
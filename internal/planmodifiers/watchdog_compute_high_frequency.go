@@ -29,7 +29,12 @@ func WatchdogComputeHighFrequency(ctx context.Context, watchdogPath path.Path, w
 	nativeServicePlan := watchdogPlan.Service.NativeService()
 
 	if nativeServicePlan != nil {
-		if !watchdogConfig.HighFrequency.IsNull() {
+		highFreq := nativeServicePlan.GetFrequency().ValueInt64() < 60000
+
+		// A configured value is only tolerated when it agrees with the
+		// frequency, as is the case in generated configuration.
+		configured := watchdogConfig.HighFrequency
+		if !configured.IsNull() && !configured.IsUnknown() && configured.ValueBool() != highFreq {
 			resp.Diagnostics.AddAttributeError(
 				highFrequencyPath,
 				"Cannot set Watchdog High-frequency when using Native Service Types",
@@ -38,7 +43,6 @@ func WatchdogComputeHighFrequency(ctx context.Context, watchdogPath path.Path, w
 			)
 		}
 
-		highFreq := nativeServicePlan.GetFrequency().ValueInt64() < 60000
 		resp.Plan.SetAttribute(ctx, highFrequencyPath, types.BoolValue(highFreq))
 	}
 }
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ComponentListResource{}
+var _ list.ListResourceWithConfigure = &ComponentListResource{}
+
+func NewComponentListResource() list.ListResource {
+	return &ComponentListResource{}
+}
+
+// ComponentListResource defines the list resource implementation.
+type ComponentListResource struct {
+	client *hundApiV1.Client
+}
+
+// ComponentListResourceModel describes the list resource data model.
+type ComponentListResourceModel struct {
+	Group        types.String `tfsdk:"group"`
+	Issue        types.String `tfsdk:"issue"`
+	Event        types.String `tfsdk:"event"`
+	TimelineItem types.String `tfsdk:"timeline_item"`
+}
+
+func (r *ComponentListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component"
+}
+
+func (r *ComponentListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Components of the status page, optionally filtered by the following arguments.",
+
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Return the Components for the provided Group ObjectId.",
+				Optional:            true,
+			},
+			"issue": schema.StringAttribute{
+				MarkdownDescription: "Return the Components for the provided Issue ObjectId.",
+				Optional:            true,
+			},
+			"event": schema.StringAttribute{
+				MarkdownDescription: "Return the Components for the provided Event ObjectId.",
+				Optional:            true,
+			},
+			"timeline_item": schema.StringAttribute{
+				MarkdownDescription: "Return the Components for the provided TimelineItem ObjectId.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ComponentListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResourceClient(req, resp)
+}
+
+func (r *ComponentListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ComponentListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	limit := listPageSize
+	params := hundApiV1.GetAllComponentsParams{
+		Group:        data.Group.ValueStringPointer(),
+		Issue:        data.Issue.ValueStringPointer(),
+		Event:        data.Event.ValueStringPointer(),
+		TimelineItem: data.TimelineItem.ValueStringPointer(),
+
+		Limit: &limit,
+	}
+
+	retrievePage := func(startingAfter *string, diags *diag.Diagnostics) ([]hundApiV1.ComponentExpansionary, bool) {
+		params.StartingAfter = startingAfter
+
+		rsp, err := r.client.GetAllComponents(ctx, &params, hundApiV1.Expand("data.watchdog"))
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund Components",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		components, err := hundApiV1.ParseGetAllComponentsResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund Components",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		if components.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(components.StatusCode())+
+					"\nError: "+string(components.Body),
+			)
+			return nil, false
+		}
+
+		return components.HALJSON200.Data, components.HALJSON200.HasMore
+	}
+
+	stream.Results = streamListResults(ctx, req, retrievePage, func(component hundApiV1.ComponentExpansionary, result *list.ListResult) string {
+		result.DisplayName = i18nStringOriginal(component.Name)
+
+		model, diag := models.ToComponentModel(ctx, component)
+		result.Diagnostics.Append(diag...)
+
+		if result.Diagnostics.HasError() {
+			return component.Id
+		}
+
+		// Shape the state as an imported Component would be.
+		model.DeletionProtection = types.BoolValue(true)
+		importedI18nAttributes(&model.Name, &model.NameTranslations)
+		importedI18nAttributes(&model.Description, &model.DescriptionTranslations)
+
+		setListResult(ctx, req, result, component.Id, ComponentResourceModel(model))
+
+		return component.Id
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,6 +34,7 @@ import (
 var _ resource.Resource = &ComponentResource{}
 var _ resource.ResourceWithConfigure = &ComponentResource{}
 var _ resource.ResourceWithImportState = &ComponentResource{}
+var _ resource.ResourceWithIdentity = &ComponentResource{}
 var _ resource.ResourceWithConfigValidators = &ComponentResource{}
 var _ resource.ResourceWithModifyPlan = &ComponentResource{}
 
@@ -42,7 +44,13 @@ func NewComponentResource() resource.Resource {
 
 // ComponentResource defines the resource implementation.
 type ComponentResource struct {
-	client *hundApiV1.Client
+	client     *hundApiV1.Client
+	groupMutex *MutexKV
+	locales    []string
+
+	renderMarkdownLocally bool
+	conflictDetection     bool
+	readOnly              bool
 }
 
 // ComponentResourceModel describes the resource data model.
@@ -83,6 +91,22 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "The ID of the Group that this Component belongs to.",
 				Required:            true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "When true, this Component cannot be destroyed, as destroying a Component permanently removes its uptime and event history from your status page. Set to false (and apply) before destroying this Component. Has no effect when `archive_instead` is set. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"archive_instead": schema.SingleNestedAttribute{
+				MarkdownDescription: "When set, destroying this resource will not delete the Component from your status page. Instead, the Component is moved to the given Group (e.g. a hidden archive Group), and excluded from global uptime and history.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"group": schema.StringAttribute{
+						MarkdownDescription: "The ID of the Group to which this Component is moved when destroyed.",
+						Required:            true,
+					},
+				},
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: translationOriginalFieldMarkdownDescription("The name of this Component."),
 				Optional:            true,
@@ -96,6 +120,9 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -113,6 +140,9 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -215,8 +245,8 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 								Optional:            true,
 								Attributes: map[string]schema.Attribute{
 									"monitor_api_key": schema.StringAttribute{
-										MarkdownDescription: "An Updown.io monitor API key. This API key can be read-only.",
-										Required:            true,
+										MarkdownDescription: "An Updown.io monitor API key. This API key can be read-only. Required when creating this service. The Hund API never returns this value, so it may be omitted (e.g. after import) once the service exists, in which case the current value is kept.",
+										Optional:            true,
 										Sensitive:           true,
 									},
 									"monitor_token": schema.StringAttribute{
@@ -230,8 +260,8 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 								Optional:            true,
 								Attributes: map[string]schema.Attribute{
 									"api_token": schema.StringAttribute{
-										MarkdownDescription: "The Pingdom API v3 key.",
-										Required:            true,
+										MarkdownDescription: "The Pingdom API v3 key. Required when creating this service. The Hund API never returns this value, so it may be omitted (e.g. after import) once the service exists, in which case the current value is kept.",
+										Optional:            true,
 										Sensitive:           true,
 									},
 									"check_id": schema.StringAttribute{
@@ -263,8 +293,8 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 								Optional:            true,
 								Attributes: map[string]schema.Attribute{
 									"monitor_api_key": schema.StringAttribute{
-										MarkdownDescription: "An Uptime Robot monitor API key to retrieve status from.",
-										Required:            true,
+										MarkdownDescription: "An Uptime Robot monitor API key to retrieve status from. Required when creating this service. The Hund API never returns this value, so it may be omitted (e.g. after import) once the service exists, in which case the current value is kept.",
+										Optional:            true,
 										Sensitive:           true,
 									},
 									"unconfirmed_is_down": schema.BoolAttribute{
@@ -284,6 +314,9 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 										Optional:            true,
 										Computed:            true,
 										Sensitive:           true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
 									},
 									"deadman": schema.BoolAttribute{
 										MarkdownDescription: "When true, turns on a \"Dead Man's Switch\" for the Watchdog, according to the" +
@@ -340,7 +373,28 @@ func (r *ComponentResource) ConfigValidators(ctx context.Context) []resource.Con
 }
 
 func (r *ComponentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+	checkTranslationLocales(ctx, r.locales, req.Config, &resp.Diagnostics)
+
+	if r.renderMarkdownLocally {
+		// Predict the rendering once the plan is otherwise complete.
+		defer predictMarkdownHtml(ctx, &resp.Plan, "description", &resp.Diagnostics)
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var servicePlan, serviceState types.Object
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("watchdog").AtName("service"), &servicePlan)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("watchdog").AtName("service"), &serviceState)...)
+	}
+
+	validateWatchdogServiceCredentials(path.Root("watchdog").AtName("service"), servicePlan, serviceState, &resp.Diagnostics)
+
+	if req.State.Raw.IsNull() {
 		return
 	}
 
@@ -379,27 +433,44 @@ func (r *ComponentResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	}
 }
 
+func (r *ComponentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ObjectId of this Component.")
+}
+
 func (r *ComponentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*hundApiV1.Client)
+	data, ok := req.ProviderData.(*HundResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hundApiV1.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.locales = data.Locales
+	r.renderMarkdownLocally = data.RenderMarkdownLocally
+	r.conflictDetection = data.ConflictDetection
+	r.groupMutex = data.GroupMutex
 }
 
 func (r *ComponentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_component", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_component"))
+		return
+	}
+
 	var data ComponentResourceModel
 
 	// Read Terraform plan data into the model
@@ -438,7 +509,10 @@ func (r *ComponentResource) Create(ctx context.Context, req resource.CreateReque
 		},
 	}
 
+	unlock := r.groupMutex.LockAll(data.Group.ValueString())
 	rsp, err := r.client.CreateAComponent(ctx, form, hundApiV1.Expand("watchdog"))
+	unlock()
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Hund Component",
@@ -468,10 +542,17 @@ func (r *ComponentResource) Create(ctx context.Context, req resource.CreateReque
 	newState, diag := models.ToComponentModel(ctx, *component.HALJSON201)
 	resp.Diagnostics.Append(diag...)
 
+	newState.DeletionProtection = data.DeletionProtection
+	newState.ArchiveInstead = data.ArchiveInstead
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if r.renderMarkdownLocally {
+		reconcileMarkdownHtml("description", data.DescriptionHtml, &newState.DescriptionHtml, data.DescriptionHtmlTranslations, &newState.DescriptionHtmlTranslations, &resp.Diagnostics)
+	}
+
 	newState.Watchdog.Service.ReplaceSensitiveAttributes(data.Watchdog.Service)
 
 	// Write logs using the tflog package
@@ -480,9 +561,13 @@ func (r *ComponentResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *ComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_component", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	var data ComponentResourceModel
 
 	// Read Terraform prior state data into the model
@@ -527,23 +612,45 @@ func (r *ComponentResource) Read(ctx context.Context, req resource.ReadRequest,
 	newState, diag := models.ToComponentModel(ctx, *component.HALJSON200)
 	resp.Diagnostics.Append(diag...)
 
+	newState.DeletionProtection = data.DeletionProtection
+	newState.ArchiveInstead = data.ArchiveInstead
+
+	if newState.DeletionProtection.IsNull() {
+		newState.DeletionProtection = types.BoolValue(true)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	if data.Watchdog != nil {
 		newState.Watchdog.Service.ReplaceSensitiveAttributes(data.Watchdog.Service)
+	} else {
+		// This Component is being imported: shape the state as it would be
+		// configured, so that generated configuration passes validation.
+		importedI18nAttributes(&newState.Name, &newState.NameTranslations)
+		importedI18nAttributes(&newState.Description, &newState.DescriptionTranslations)
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *ComponentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ComponentResourceModel
+	ctx, span := startResourceSpan(ctx, "hund_component", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_component"))
+		return
+	}
+
+	var data, state ComponentResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	var watchdogPlan, watchdogState types.Object
 
@@ -558,6 +665,14 @@ func (r *ComponentResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	if r.conflictDetection {
+		r.detectConflict(ctx, state, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var watchdogForm *hundApiV1.WatchdogFormUpdate
 
 	servicePlan, ok := watchdogPlan.Attributes()["service"].(types.Object)
@@ -653,7 +768,11 @@ func (r *ComponentResource) Update(ctx context.Context, req resource.UpdateReque
 		Watchdog:                 watchdogForm,
 	}
 
+	// Moving a Component changes the membership of both its old and new Group.
+	unlock := r.groupMutex.LockAll(state.Group.ValueString(), data.Group.ValueString())
 	rsp, err := r.client.UpdateAComponent(ctx, data.Id.ValueString(), form, hundApiV1.Expand("watchdog"))
+	unlock()
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Update Hund Component",
@@ -683,17 +802,33 @@ func (r *ComponentResource) Update(ctx context.Context, req resource.UpdateReque
 	newState, diag := models.ToComponentModel(ctx, *component.HALJSON200)
 	resp.Diagnostics.Append(diag...)
 
+	newState.DeletionProtection = data.DeletionProtection
+	newState.ArchiveInstead = data.ArchiveInstead
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if r.renderMarkdownLocally {
+		reconcileMarkdownHtml("description", data.DescriptionHtml, &newState.DescriptionHtml, data.DescriptionHtmlTranslations, &newState.DescriptionHtmlTranslations, &resp.Diagnostics)
+	}
+
 	newState.Watchdog.Service.ReplaceSensitiveAttributes(data.Watchdog.Service)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *ComponentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_component", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_component"))
+		return
+	}
+
 	var data ComponentResourceModel
 
 	// Read Terraform prior state data into the model
@@ -703,7 +838,20 @@ func (r *ComponentResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if data.ArchiveInstead != nil {
+		r.archiveComponent(ctx, data, &resp.Diagnostics)
+		return
+	}
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(ComponentDeletionProtectionError())
+		return
+	}
+
+	unlock := r.groupMutex.LockAll(data.Group.ValueString())
 	rsp, err := r.client.DeleteAComponent(ctx, data.Id.ValueString())
+	unlock()
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Delete Hund Component",
@@ -729,6 +877,105 @@ func (r *ComponentResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// detectConflict reports an error when the Component was modified outside
+// Terraform since the prior state was read.
+func (r *ComponentResource) detectConflict(ctx context.Context, state ComponentResourceModel, diags *diag.Diagnostics) {
+	rsp, err := r.client.RetrieveAComponent(ctx, state.Id.ValueString(), hundApiV1.Expand("watchdog"))
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Hund Component",
+			err.Error(),
+		)
+		return
+	}
+
+	component, err := hundApiV1.ParseRetrieveAComponentResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Component",
+			err.Error(),
+		)
+		return
+	}
+
+	if component.StatusCode() != 200 {
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-200 status code: "+fmt.Sprint(component.StatusCode())+
+				"\nError: "+string(component.Body),
+		)
+		return
+	}
+
+	remote, diag := models.ToComponentModel(ctx, *component.HALJSON200)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return
+	}
+
+	if state.Watchdog != nil && remote.Watchdog != nil {
+		remote.Watchdog.Service.ReplaceSensitiveAttributes(state.Watchdog.Service)
+	}
+
+	diags.Append(detectConflict("Component", state, ComponentResourceModel(remote), "last_event_at", "percent_uptime", "deletion_protection", "archive_instead")...)
+}
+
+func (r *ComponentResource) archiveComponent(ctx context.Context, data ComponentResourceModel, diags *diag.Diagnostics) {
+	excluded := true
+
+	form := hundApiV1.ComponentFormUpdate{
+		Group:                    data.ArchiveInstead.Group.ValueStringPointer(),
+		ExcludeFromGlobalHistory: &excluded,
+		ExcludeFromGlobalUptime:  &excluded,
+	}
+
+	unlock := r.groupMutex.LockAll(data.Group.ValueString(), data.ArchiveInstead.Group.ValueString())
+	rsp, err := r.client.UpdateAComponent(ctx, data.Id.ValueString(), form)
+	unlock()
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Archive Hund Component",
+			err.Error(),
+		)
+		return
+	}
+
+	if rsp.StatusCode == 404 {
+		return
+	}
+
+	component, err := hundApiV1.ParseUpdateAComponentResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Component",
+			err.Error(),
+		)
+		return
+	}
+
+	if component.StatusCode() != 200 {
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-200 status code: "+fmt.Sprint(component.StatusCode())+
+				"\nError: "+string(component.Body),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "archived component instead of deleting", map[string]interface{}{
+		"component": data.Id.ValueString(),
+		"group":     data.ArchiveInstead.Group.ValueString(),
+	})
+}
+
 func (r *ComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := resolveComponentImportId(ctx, r.client, importIdentityId(ctx, req, &resp.Diagnostics), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -4,10 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
 )
 
 func init() {
@@ -74,7 +80,15 @@ func TestAccComponentResource(t *testing.T) {
 				ResourceName:            "hund_component.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"last_event_at"},
+				ImportStateVerifyIgnore: []string{"last_event_at", "deletion_protection"},
+			},
+			// ImportState by name testing
+			{
+				ResourceName:            "hund_component.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccComponentResourceImportStateIdByName("hund_component.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_event_at", "deletion_protection"},
 			},
 			// Update and Read testing
 			{
@@ -88,6 +102,394 @@ func TestAccComponentResource(t *testing.T) {
 	})
 }
 
+func TestAccComponentResource_importGeneratedConfig(t *testing.T) {
+	cases := []struct {
+		service   string
+		config    string
+		generated string
+	}{
+		{
+			service: "icmp",
+			config:  `{ target = "example.com", regions = ["wa-us-1"] }`,
+			generated: `{
+        consecutive_check_degraded_threshold = null
+        consecutive_check_outage_threshold   = 1
+        frequency                            = 60000
+        ip_version                           = "inet"
+        percentage_failed_threshold          = 0.5
+        percentage_regions_failed_threshold  = 0.5
+        regions                              = ["wa-us-1"]
+        target                               = "example.com"
+        timeout                              = 15000
+      }`,
+		},
+		{
+			service: "http",
+			config:  `{ target = "https://example.com", regions = ["wa-us-1"], username = "hund", password = "secret", response_code_must_be = 200 }`,
+			generated: `{
+        consecutive_check_degraded_threshold = null
+        consecutive_check_outage_threshold   = 1
+        follow_redirects                     = true
+        frequency                            = 60000
+        headers                              = {}
+        password                             = null # sensitive
+        percentage_regions_failed_threshold  = 0.5
+        regions                              = ["wa-us-1"]
+        response_body_must_contain           = null
+        response_body_must_contain_mode      = "exact"
+        response_code_must_be                = 200
+        ssl_verify_peer                      = true
+        target                               = "https://example.com"
+        timeout                              = 15000
+        username                             = "hund"
+      }`,
+		},
+		{
+			service: "dns",
+			config:  `{ target = "example.com", regions = ["wa-us-1"], record_type = "A", nameservers = ["1.1.1.1"], response_containment = "any", responses_must_contain = ["93.184.215.14"] }`,
+			generated: `{
+        consecutive_check_degraded_threshold = null
+        consecutive_check_outage_threshold   = 1
+        frequency                            = 60000
+        nameservers                          = ["1.1.1.1"]
+        percentage_regions_failed_threshold  = 0.5
+        record_type                          = "A"
+        regions                              = ["wa-us-1"]
+        response_containment                 = "any"
+        responses_must_contain               = ["93.184.215.14"]
+        target                               = "example.com"
+        timeout                              = 15000
+      }`,
+		},
+		{
+			service: "tcp",
+			config:  `{ target = "example.com", regions = ["wa-us-1"], port = 25, response_must_contain = "220", wait_for_initial_response = true }`,
+			generated: `{
+        consecutive_check_degraded_threshold = null
+        consecutive_check_outage_threshold   = 1
+        frequency                            = 60000
+        ip_version                           = "inet"
+        percentage_regions_failed_threshold  = 0.5
+        port                                 = 25
+        regions                              = ["wa-us-1"]
+        response_must_contain                = "220"
+        response_must_contain_mode           = "exact"
+        send_data                            = null
+        target                               = "example.com"
+        timeout                              = 15000
+        wait_for_initial_response            = true
+      }`,
+		},
+		{
+			service: "udp",
+			config:  `{ target = "example.com", regions = ["wa-us-1"], port = 53, send_data = "ping" }`,
+			generated: `{
+        consecutive_check_degraded_threshold = null
+        consecutive_check_outage_threshold   = 1
+        frequency                            = 60000
+        ip_version                           = "inet"
+        percentage_regions_failed_threshold  = 0.5
+        port                                 = 53
+        regions                              = ["wa-us-1"]
+        response_must_contain                = null
+        response_must_contain_mode           = "exact"
+        send_data                            = "ping"
+        target                               = "example.com"
+        timeout                              = 15000
+      }`,
+		},
+		{
+			service: "updown",
+			config:  `{ monitor_api_key = "key", monitor_token = "token" }`,
+			generated: `{
+        monitor_api_key = null # sensitive
+        monitor_token   = "token"
+      }`,
+		},
+		{
+			service: "pingdom",
+			config:  `{ api_token = "token", check_id = "1234" }`,
+			generated: `{
+        api_token           = null # sensitive
+        check_id            = "1234"
+        check_type          = "check"
+        unconfirmed_is_down = false
+      }`,
+		},
+		{
+			service: "uptimerobot",
+			config:  `{ monitor_api_key = "key" }`,
+			generated: `{
+        monitor_api_key     = null # sensitive
+        unconfirmed_is_down = false
+      }`,
+		},
+		{
+			service: "webhook",
+			config:  `{}`,
+			generated: `{
+        consecutive_checks = null
+        deadman            = false
+        reporting_interval = null
+        webhook_key        = null # sensitive
+      }`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.service, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_5_0),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testAccComponentResourceServiceConfig(t.Name(), c.service, c.config),
+					},
+					// Import into configuration shaped like that of `terraform plan
+					// -generate-config-out`, which should plan no changes.
+					{
+						ResourceName:    "hund_component.test",
+						Config:          testAccComponentResourceGeneratedConfig(t.Name(), c.service, c.generated),
+						ImportState:     true,
+						ImportStateKind: resource.ImportBlockWithID,
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestComponentFixture_watchdogServices decodes the Watchdog of a Component
+// of each service type, as recorded from the Hund API, covering every member
+// of the service discriminator union.
+func TestComponentFixture_watchdogServices(t *testing.T) {
+	ctx := context.Background()
+	client := testFixtureClient(t)
+
+	cases := []struct {
+		name     string
+		service  string
+		expected func(models.WatchdogServiceModel) bool
+	}{
+		{"manual", `{"type":"manual","state":1}`, func(s models.WatchdogServiceModel) bool { return s.Manual != nil }},
+		{"updown", `{"type":"updown","monitor_api_key":"key","monitor_token":"token"}`, func(s models.WatchdogServiceModel) bool { return s.Updown != nil }},
+		{"pingdom", `{"type":"pingdom","api_token":"token","check_id":"1234","check_type":"http"}`, func(s models.WatchdogServiceModel) bool { return s.Pingdom != nil }},
+		{"uptimerobot", `{"type":"uptimerobot","monitor_api_key":"key"}`, func(s models.WatchdogServiceModel) bool { return s.Uptimerobot != nil }},
+		{"webhook", `{"type":"webhook"}`, func(s models.WatchdogServiceModel) bool { return s.Webhook != nil }},
+		{"icmp", `{"type":"native","method":"icmp","target":"example.com","regions":["wa-us-1"]}`, func(s models.WatchdogServiceModel) bool { return s.NativeIcmp != nil }},
+		{"http", `{"type":"native","method":"http","target":"https://example.com","regions":["wa-us-1"]}`, func(s models.WatchdogServiceModel) bool { return s.NativeHttp != nil }},
+		{"dns", `{"type":"native","method":"dns","target":"example.com","record_type":"A","regions":["wa-us-1"]}`, func(s models.WatchdogServiceModel) bool { return s.NativeDns != nil }},
+		{"tcp", `{"type":"native","method":"tcp","target":"example.com","port":443,"regions":["wa-us-1"]}`, func(s models.WatchdogServiceModel) bool { return s.NativeTcp != nil }},
+		{"udp", `{"type":"native","method":"udp","target":"example.com","port":53,"send_data":"ping","regions":["wa-us-1"]}`, func(s models.WatchdogServiceModel) bool { return s.NativeUdp != nil }},
+	}
+
+	group := testFixtureCreateGroup(t, client, `{"name": "Fixture Watchdog Services"}`)
+
+	for _, c := range cases {
+		service := hundApiV1.FormWatchdogCreate{}
+		if err := service.UnmarshalJSON([]byte(c.service)); err != nil {
+			t.Fatal(err)
+		}
+
+		name := hundApiV1.I18nString{}
+		if err := name.FromI18nString0("Fixture " + c.name); err != nil {
+			t.Fatal(err)
+		}
+
+		rsp, err := client.CreateAComponent(ctx, hundApiV1.ComponentFormCreate{
+			Group:    group.Id,
+			Name:     name,
+			Watchdog: hundApiV1.WatchdogFormCreate{Service: service},
+		}, hundApiV1.Expand("watchdog"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		component, err := hundApiV1.ParseCreateAComponentResponse(rsp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if component.StatusCode() != 201 {
+			t.Fatalf("%s: expected to create a component, got %d: %s", c.name, component.StatusCode(), component.Body)
+		}
+
+		rsp, err = client.RetrieveAComponent(ctx, component.HALJSON201.Id, hundApiV1.Expand("watchdog"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		retrieved, err := hundApiV1.ParseRetrieveAComponentResponse(rsp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if retrieved.StatusCode() != 200 {
+			t.Fatalf("%s: expected to retrieve the component, got %d: %s", c.name, retrieved.StatusCode(), retrieved.Body)
+		}
+
+		model, diags := models.ToComponentModel(ctx, *retrieved.HALJSON200)
+		if diags.HasError() {
+			t.Fatalf("%s: %v", c.name, diags)
+		}
+
+		if model.Watchdog == nil || !c.expected(model.Watchdog.Service) {
+			t.Errorf("%s: decoded the wrong service: %+v", c.name, model.Watchdog)
+		}
+
+		if _, err := client.DeleteAComponent(ctx, model.Id.ValueString()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := client.DeleteAGroup(ctx, group.Id); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImportedI18nAttributes(t *testing.T) {
+	single := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"original": types.StringValue("en"),
+		"en":       types.StringValue("one"),
+	})
+
+	multiple := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"original": types.StringValue("en"),
+		"en":       types.StringValue("one"),
+		"de":       types.StringValue("eins"),
+	})
+
+	cases := []struct {
+		name                 string
+		original             types.String
+		translations         types.Map
+		expectedOriginal     types.String
+		expectedTranslations types.Map
+	}{
+		{"single", types.StringValue("one"), single, types.StringValue("one"), types.MapNull(types.StringType)},
+		{"multiple", types.StringValue("one"), multiple, types.StringNull(), multiple},
+		{"unset", types.StringNull(), types.MapNull(types.StringType), types.StringNull(), types.MapNull(types.StringType)},
+	}
+
+	for _, c := range cases {
+		original, translations := c.original, c.translations
+
+		importedI18nAttributes(&original, &translations)
+
+		if !original.Equal(c.expectedOriginal) || !translations.Equal(c.expectedTranslations) {
+			t.Errorf("%s: got (%s, %s), expected (%s, %s)", c.name, original, translations, c.expectedOriginal, c.expectedTranslations)
+		}
+	}
+}
+
+func TestAccComponentResource_deletionProtection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentResourceProtectedConfig(true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_component.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccComponentResourceProtectedConfig(false, true),
+				ExpectError: regexp.MustCompile("Component Deletion Protection"),
+			},
+			{
+				Config: testAccComponentResourceProtectedConfig(true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_component.test", "deletion_protection", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccComponentResource_archiveInstead(t *testing.T) {
+	var componentId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The archive Group deletes the archived Component when destroyed, so
+		// that none is left behind on the status page.
+		CheckDestroy: func(s *terraform.State) error {
+			client, err := sharedClientForDomain("")
+			if err != nil {
+				return err
+			}
+
+			rsp, err := client.RetrieveAComponent(context.Background(), componentId)
+			if err != nil {
+				return err
+			}
+
+			if rsp.StatusCode != 404 {
+				return fmt.Errorf("expected archived component %s to be deleted, got status %d", componentId, rsp.StatusCode)
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentResourceArchiveConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hund_component.test", "archive_instead.group", "hund_group.archive", "id"),
+					func(s *terraform.State) error {
+						componentId = s.RootModule().Resources["hund_component.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccComponentResourceArchiveConfig(false),
+				Check: func(s *terraform.State) error {
+					client, err := sharedClientForDomain("")
+					if err != nil {
+						return err
+					}
+
+					rsp, err := client.RetrieveAComponent(context.Background(), componentId)
+					if err != nil {
+						return err
+					}
+
+					component, err := hundApiV1.ParseRetrieveAComponentResponse(rsp)
+					if err != nil {
+						return err
+					}
+
+					if component.StatusCode() != 200 {
+						return errors.New("archived component was not found:\n" + string(component.Body))
+					}
+
+					group, err := component.HALJSON200.Group.AsComponentExpansionaryGroup0()
+					if err != nil {
+						return err
+					}
+
+					archive := s.RootModule().Resources["hund_group.archive"].Primary.ID
+					if group != archive {
+						return fmt.Errorf("expected component to be archived to group %s", archive)
+					}
+
+					if !component.HALJSON200.ExcludeFromGlobalUptime || !component.HALJSON200.ExcludeFromGlobalHistory {
+						return errors.New("expected archived component to be excluded from global uptime and history")
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func testAccComponentResourceConfig(name string) string {
 	return providerConfig + fmt.Sprintf(`
 resource "hund_group" "test" {
@@ -95,6 +497,7 @@ resource "hund_group" "test" {
 }
 
 resource "hund_component" "test" {
+  deletion_protection = false
   name = %[1]q
 
 	group = hund_group.test.id
@@ -104,6 +507,61 @@ resource "hund_component" "test" {
 `, name)
 }
 
+func testAccComponentResourceServiceConfig(groupName string, service string, block string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "hund_group" "test" {
+  name = %[1]q
+}
+
+resource "hund_component" "test" {
+  deletion_protection = false
+  name = "Imported Component"
+
+  group = hund_group.test.id
+
+  watchdog = {service = { %[2]s = %[3]s }}
+}
+`, groupName, service, block)
+}
+
+// testAccComponentResourceGeneratedConfig returns configuration shaped like
+// that generated by Terraform for an imported Component, whose Watchdog uses
+// the given service, and whose other services are null. Secrets are never
+// returned by the Hund API, so they are generated as null.
+func testAccComponentResourceGeneratedConfig(groupName string, service string, block string) string {
+	services := ""
+	for _, name := range []string{"dns", "http", "icmp", "manual", "pingdom", "tcp", "udp", "updown", "uptimerobot", "webhook"} {
+		if name == service {
+			services += fmt.Sprintf("      %s = %s\n", name, block)
+		} else {
+			services += fmt.Sprintf("      %s = null\n", name)
+		}
+	}
+
+	return providerConfig + fmt.Sprintf(`
+resource "hund_group" "test" {
+  name = %[1]q
+}
+
+resource "hund_component" "test" {
+  archive_instead             = null
+  deletion_protection         = true
+  description                 = null
+  description_translations    = null
+  exclude_from_global_history = false
+  exclude_from_global_uptime  = false
+  group                       = hund_group.test.id
+  name                        = "Imported Component"
+  name_translations           = null
+  watchdog = {
+    high_frequency = false
+    service = {
+%[2]s    }
+  }
+}
+`, groupName, services)
+}
+
 func testAccComponentResourceConfigI18n(name_en string, name_de string) string {
 	return providerConfig + fmt.Sprintf(`
 resource "hund_group" "test" {
@@ -111,6 +569,7 @@ resource "hund_group" "test" {
 }
 
 resource "hund_component" "test" {
+  deletion_protection = false
   name_translations = {
 		en = %[1]q,
 		de = %[2]q,
@@ -123,3 +582,71 @@ resource "hund_component" "test" {
 }
 `, name_en, name_de)
 }
+
+func testAccComponentResourceProtectedConfig(withComponent bool, protected bool) string {
+	config := providerConfig + `
+resource "hund_group" "test" {
+  name = "Test Group"
+}
+`
+
+	if withComponent {
+		config += fmt.Sprintf(`
+resource "hund_component" "test" {
+  deletion_protection = %[1]v
+  name = "Protected"
+
+  group = hund_group.test.id
+
+  watchdog = { service = { manual = {} } }
+}
+`, protected)
+	}
+
+	return config
+}
+
+func testAccComponentResourceArchiveConfig(withComponent bool) string {
+	config := providerConfig + `
+resource "hund_group" "test" {
+  name = "Test Group"
+}
+
+resource "hund_group" "archive" {
+  name = "Archive"
+
+  on_destroy = {
+    delete_components = true
+  }
+}
+`
+
+	if withComponent {
+		config += `
+resource "hund_component" "test" {
+  name = "Archived"
+
+  group = hund_group.test.id
+
+  archive_instead = {
+    group = hund_group.archive.id
+  }
+
+  watchdog = { service = { manual = {} } }
+}
+`
+	}
+
+	return config
+}
+
+func testAccComponentResourceImportStateIdByName(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		component, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource name not found: %s", resourceName)
+		}
+
+		return component.Primary.Attributes["group"] + "/" + component.Primary.Attributes["name"], nil
+	}
+}
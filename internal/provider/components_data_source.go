@@ -103,6 +103,17 @@ func (d *ComponentsDataSource) Schema(ctx context.Context, req datasource.Schema
 						"percent_uptime": schema.Float64Attribute{
 							Computed: true,
 						},
+						"deletion_protection": schema.BoolAttribute{
+							Computed: true,
+						},
+						"archive_instead": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"group": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
 						"watchdog": schema.SingleNestedAttribute{
 							Computed: true,
 							Attributes: map[string]schema.Attribute{
@@ -69,6 +69,7 @@ func testAccComponentsDataSourceConfig_base() string {
 		}
 
 		resource "hund_component" "test0" {
+			deletion_protection = false
 			group = hund_group.test0.id
 			name = "Test Component"
 
@@ -76,6 +77,7 @@ func testAccComponentsDataSourceConfig_base() string {
 		}
 
 		resource "hund_component" "test1" {
+			deletion_protection = false
 			group = hund_group.test1.id
 			name = "Test Component"
 
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// detectConflict returns an error when the remote object was updated since
+// the prior state was read, i.e. their `updated_at` differ, listing the
+// attributes changed remotely. Both prior and remote must be models of the
+// same type. Attributes which are not returned by the Hund API, or which
+// change on their own, are given as ignored.
+func detectConflict(kind string, prior any, remote any, ignored ...string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	priorUpdatedAt, _ := modelAttribute(prior, "updated_at").(types.String)
+	remoteUpdatedAt, _ := modelAttribute(remote, "updated_at").(types.String)
+
+	if priorUpdatedAt.IsNull() || priorUpdatedAt.IsUnknown() || priorUpdatedAt.Equal(remoteUpdatedAt) {
+		return diags
+	}
+
+	id, _ := modelAttribute(prior, "id").(types.String)
+
+	diags.Append(ConflictError(kind, id.ValueString(), priorUpdatedAt.ValueString(), remoteUpdatedAt.ValueString(), remoteChanges(prior, remote, ignored...)))
+
+	return diags
+}
+
+// remoteChanges lists the attributes of the remote model which differ from
+// the prior model, other than `updated_at` and the given ignored attributes.
+func remoteChanges(prior any, remote any, ignored ...string) []string {
+	priorValue := reflect.ValueOf(prior)
+	remoteValue := reflect.ValueOf(remote)
+
+	changes := []string{}
+
+	for i := 0; i < priorValue.NumField(); i++ {
+		name := priorValue.Type().Field(i).Tag.Get("tfsdk")
+
+		if name == "" || name == "updated_at" || slices.Contains(ignored, name) {
+			continue
+		}
+
+		priorField := priorValue.Field(i).Interface()
+		remoteField := remoteValue.Field(i).Interface()
+
+		if priorAttr, ok := priorField.(attr.Value); ok {
+			if !priorAttr.Equal(remoteField.(attr.Value)) {
+				changes = append(changes, fmt.Sprintf("`%s`: %s -> %s", name, priorAttr, remoteField))
+			}
+		} else if !reflect.DeepEqual(priorField, remoteField) {
+			changes = append(changes, fmt.Sprintf("`%s` changed", name))
+		}
+	}
+
+	return changes
+}
+
+// modelAttribute returns the attribute of the model with the given name, or
+// nil if there is none.
+func modelAttribute(model any, name string) attr.Value {
+	value := reflect.ValueOf(model)
+
+	for i := 0; i < value.NumField(); i++ {
+		if value.Type().Field(i).Tag.Get("tfsdk") == name {
+			attribute, _ := value.Field(i).Interface().(attr.Value)
+			return attribute
+		}
+	}
+
+	return nil
+}
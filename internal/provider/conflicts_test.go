@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+func TestGroupDetectConflict(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	server := hundtest.NewServer()
+	server.Now = func() time.Time { return now }
+	defer server.Close()
+
+	client, err := newProviderClient("test", server.Endpoint(), "hundtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &GroupResource{client: client, conflictDetection: true}
+
+	name := hundApiV1.I18nString{}
+	if err := name.FromI18nString0("Before"); err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := client.CreateAGroup(ctx, hundApiV1.GroupFormCreate{Name: name}, hundApiV1.Unexpand("components"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := hundApiV1.ParseCreateAGroupResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group.StatusCode() != 201 {
+		t.Fatalf("expected to create a group, got %d: %s", group.StatusCode(), group.Body)
+	}
+
+	state, diags := models.ToGroupModel(*group.HALJSON201)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	diags = diag.Diagnostics{}
+	r.detectConflict(ctx, GroupResourceModel(state), &diags)
+
+	if diags.HasError() {
+		t.Fatalf("expected no conflict for an unmodified Group, got %v", diags)
+	}
+
+	now = now.Add(time.Minute)
+
+	if err := name.FromI18nString0("After"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.UpdateAGroup(ctx, state.Id.ValueString(), hundApiV1.GroupFormUpdate{Name: &name}); err != nil {
+		t.Fatal(err)
+	}
+
+	diags = diag.Diagnostics{}
+	r.detectConflict(ctx, GroupResourceModel(state), &diags)
+
+	if !diags.HasError() {
+		t.Fatal("expected a conflict for a Group modified since it was read")
+	}
+
+	detail := diags.Errors()[0].Detail()
+
+	if !strings.Contains(detail, "`name`: \"Before\" -> \"After\"") || strings.Contains(detail, "`updated_at`") {
+		t.Errorf("expected the conflict to list the change of name, got %s", detail)
+	}
+}
+
+func TestComponentDetectConflict(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	server := hundtest.NewServer()
+	server.Now = func() time.Time { return now }
+	defer server.Close()
+
+	client, err := newProviderClient("test", server.Endpoint(), "hundtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &ComponentResource{client: client, conflictDetection: true}
+
+	groupName := hundApiV1.I18nString{}
+	if err := groupName.FromI18nString0("Group"); err != nil {
+		t.Fatal(err)
+	}
+
+	groupRsp, err := client.CreateAGroup(ctx, hundApiV1.GroupFormCreate{Name: groupName}, hundApiV1.Unexpand("components"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := hundApiV1.ParseCreateAGroupResponse(groupRsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group.StatusCode() != 201 {
+		t.Fatalf("expected to create a group, got %d: %s", group.StatusCode(), group.Body)
+	}
+
+	name := hundApiV1.I18nString{}
+	if err := name.FromI18nString0("Before"); err != nil {
+		t.Fatal(err)
+	}
+
+	service := hundApiV1.FormWatchdogCreate{}
+	if err := service.FromFormWatchdogCreate1(hundApiV1.UpdownFormCreate{
+		MonitorApiKey: "remote-key",
+		MonitorToken:  "token",
+		Type:          hundApiV1.UpdownFormCreateTypeUpdown,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := client.CreateAComponent(ctx, hundApiV1.ComponentFormCreate{
+		Group:    group.HALJSON201.Id,
+		Name:     name,
+		Watchdog: hundApiV1.WatchdogFormCreate{Service: service},
+	}, hundApiV1.Expand("watchdog"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	component, err := hundApiV1.ParseCreateAComponentResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if component.StatusCode() != 201 {
+		t.Fatalf("expected to create a component, got %d: %s", component.StatusCode(), component.Body)
+	}
+
+	state, diags := models.ToComponentModel(ctx, *component.HALJSON201)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// The API key of the state differs from the one returned by the API, as
+	// it is kept from the configuration, while the remaining attributes are only
+	// known to Terraform, or change on their own.
+	state.Watchdog.Service.Updown.MonitorApiKey = types.StringValue("local-key")
+	state.DeletionProtection = types.BoolValue(true)
+	state.ArchiveInstead = &models.ComponentArchiveModel{Group: types.StringValue(group.HALJSON201.Id)}
+	state.LastEventAt = types.StringValue("2024-12-31T00:00:00Z")
+	state.PercentUptime = types.Float64Value(99.5)
+
+	diags = diag.Diagnostics{}
+	r.detectConflict(ctx, ComponentResourceModel(state), &diags)
+
+	if diags.HasError() {
+		t.Fatalf("expected no conflict for an unmodified Component, got %v", diags)
+	}
+
+	now = now.Add(time.Minute)
+
+	if err := name.FromI18nString0("After"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.UpdateAComponent(ctx, state.Id.ValueString(), hundApiV1.ComponentFormUpdate{Name: &name}); err != nil {
+		t.Fatal(err)
+	}
+
+	diags = diag.Diagnostics{}
+	r.detectConflict(ctx, ComponentResourceModel(state), &diags)
+
+	if !diags.HasError() {
+		t.Fatal("expected a conflict for a Component modified since it was read")
+	}
+
+	detail := diags.Errors()[0].Detail()
+
+	if !strings.Contains(detail, "`name`: \"Before\" -> \"After\"") {
+		t.Errorf("expected the conflict to list the change of name, got %s", detail)
+	}
+
+	for _, ignored := range []string{"`watchdog`", "`deletion_protection`", "`archive_instead`", "`last_event_at`", "`percent_uptime`"} {
+		if strings.Contains(detail, ignored) {
+			t.Errorf("expected the conflict not to list %s, got %s", ignored, detail)
+		}
+	}
+
+	now = now.Add(time.Minute)
+
+	highFrequency := true
+
+	if _, err := client.UpdateAComponent(ctx, state.Id.ValueString(), hundApiV1.ComponentFormUpdate{
+		Watchdog: &hundApiV1.WatchdogFormUpdate{HighFrequency: &highFrequency},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	diags = diag.Diagnostics{}
+	r.detectConflict(ctx, ComponentResourceModel(state), &diags)
+
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "`watchdog` changed") {
+		t.Errorf("expected the conflict to list the change of watchdog, got %v", diags)
+	}
+}
+
+func TestIssueTemplateDetectConflict(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	server := hundtest.NewServer()
+	server.Now = func() time.Time { return now }
+	defer server.Close()
+
+	client, err := newProviderClient("test", server.Endpoint(), "hundtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &IssueTemplateResource{client: client, conflictDetection: true}
+
+	rsp, err := client.CreateAIssueTemplate(ctx, hundApiV1.IssueTemplateFormCreate{
+		Kind: hundApiV1.ISSUETEMPLATEKINDIssue,
+		Name: "Before",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template, err := hundApiV1.ParseCreateAIssueTemplateResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if template.StatusCode() != 201 {
+		t.Fatalf("expected to create an issue template, got %d: %s", template.StatusCode(), template.Body)
+	}
+
+	state, diags := models.ToIssueTemplateModel(*template.HALJSON201)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	diags = diag.Diagnostics{}
+	r.detectConflict(ctx, IssueTemplateResourceModel(state), &diags)
+
+	if diags.HasError() {
+		t.Fatalf("expected no conflict for an unmodified Issue Template, got %v", diags)
+	}
+
+	now = now.Add(time.Minute)
+
+	renamed := "After"
+
+	if _, err := client.UpdateAIssueTemplate(ctx, state.Id.ValueString(), hundApiV1.IssueTemplateFormUpdate{Name: &renamed}); err != nil {
+		t.Fatal(err)
+	}
+
+	diags = diag.Diagnostics{}
+	r.detectConflict(ctx, IssueTemplateResourceModel(state), &diags)
+
+	if !diags.HasError() {
+		t.Fatal("expected a conflict for an Issue Template modified since it was read")
+	}
+
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "`name`: \"Before\" -> \"After\"") {
+		t.Errorf("expected the conflict to list the change of name, got %s", detail)
+	}
+}
@@ -1,6 +1,12 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/diag"
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
 
 func WatchdogServiceError(err error) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
@@ -27,3 +33,122 @@ func IssueAndUpdateDestructionWarning() diag.Diagnostic {
 			"Issue/Update from your status page history.",
 	)
 }
+
+func ExternalIssueUpdatesWarning(updates []models.UpdateModel) diag.Diagnostic {
+	lines := make([]string, 0, len(updates))
+
+	for _, update := range updates {
+		lines = append(lines, update.Id.ValueString()+" ("+update.Label.ValueString()+", created "+update.CreatedAt.ValueString()+")")
+	}
+
+	return diag.NewWarningDiagnostic(
+		"Issue Has Updates Not Created by This Resource",
+		"The following Updates of this Issue have appeared since it was last read, "+
+			"and were not created by this `hund_issue`:\n\n  - "+
+			strings.Join(lines, "\n  - ")+"\n\n"+
+			"They may have been posted outside Terraform (for example, by responders in "+
+			"the Hund UI), or by `hund_issue_update` resources: the provider cannot tell "+
+			"these apart. Each Update is only reported once. Set `adopt_external_updates` "+
+			"to true to accept them silently, and leave them out of `updates`.",
+	)
+}
+
+func ComponentDeletionProtectionError() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Component Deletion Protection",
+		"This Component has `deletion_protection` enabled. Destroying a Component "+
+			"permanently removes its uptime and event history from your status page. "+
+			"To destroy this Component, first set `deletion_protection` to false and "+
+			"apply, or set `archive_instead` to move the Component to an archive Group "+
+			"instead of deleting it.",
+	)
+}
+
+func GroupDestructionWarning(onDestroy *models.GroupOnDestroyModel, components []string) diag.Diagnostic {
+	var outcome string
+
+	switch {
+	case onDestroy == nil:
+		outcome = "These Components may be deleted along with the Group. Set `on_destroy` to choose explicitly what happens to them."
+	case onDestroy.FailIfNotEmpty.ValueBool():
+		outcome = "Destroying this Group will fail until these Components are moved to another Group, or otherwise deleted."
+	case !onDestroy.MoveComponentsTo.IsNull():
+		outcome = "These Components will be moved to the Group " + onDestroy.MoveComponentsTo.ValueString() + "."
+	default:
+		outcome = "These Components will be deleted, along with their uptime and event history, " +
+			"regardless of the `deletion_protection` of any `hund_component` managing them."
+	}
+
+	return diag.NewWarningDiagnostic(
+		"Group Destruction Affects Components",
+		"This Group still contains the following Components:\n\n  - "+
+			strings.Join(components, "\n  - ")+"\n\n"+outcome+" Components which are "+
+			"also destroyed by this plan are destroyed before the Group, and are unaffected.",
+	)
+}
+
+func RecurringMaintenanceDriftWarning(cancel []RecurringMaintenanceIssueModel, create []RecurringMaintenanceIssueModel) diag.Diagnostic {
+	lines := make([]string, 0, len(cancel)+len(create))
+
+	for _, occurrence := range cancel {
+		lines = append(lines, "cancel "+occurrence.Id.ValueString()+" ("+occurrence.StartsAt.ValueString()+" to "+occurrence.EndsAt.ValueString()+")")
+	}
+
+	for _, occurrence := range create {
+		lines = append(lines, "schedule "+occurrence.StartsAt.ValueString()+" to "+occurrence.EndsAt.ValueString())
+	}
+
+	return diag.NewWarningDiagnostic(
+		"Recurring Maintenance Occurrences Will Change",
+		"The scheduled Issues of this recurring maintenance no longer match its "+
+			"recurrence, either because the configuration changed, occurrences have "+
+			"passed, or Issues were cancelled or deleted outside Terraform. Applying "+
+			"this plan will:\n\n  - "+strings.Join(lines, "\n  - "),
+	)
+}
+
+func RetroactiveScheduleWarning(startsAt string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Scheduled Issue Starts in the Past",
+		"This Issue is scheduled to start at "+startsAt+", which has already passed. "+
+			"Creating it publishes a retroactive maintenance, which begins immediately "+
+			"(and may already be over). If this is intended, consider a retrospective "+
+			"Issue using `began_at` and `ended_at` instead of `schedule`.",
+	)
+}
+
+func PredictedHtmlMismatchWarning(name string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Predicted HTML Differs From Hund's Rendering",
+		"The HTML rendering of `"+name+"` predicted during plan (with "+
+			"`render_markdown_locally` enabled) differs from the rendering returned by "+
+			"the Hund API. The prediction is kept in state for this apply, and the Hund "+
+			"API's rendering will be recorded on the next refresh.",
+	)
+}
+
+func ConflictError(kind string, id string, priorUpdatedAt string, remoteUpdatedAt string, changes []string) diag.Diagnostic {
+	changed := "No attribute tracked in state was changed."
+
+	if len(changes) > 0 {
+		changed = "The following attributes were changed:\n\n  - " + strings.Join(changes, "\n  - ")
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Hund "+kind+" Modified Outside Terraform",
+		"The "+kind+" "+id+" was updated at "+remoteUpdatedAt+", after Terraform last "+
+			"read it (as updated at "+priorUpdatedAt+"). With `conflict_detection` "+
+			"enabled, it is not updated, so as not to overwrite these changes. "+changed+"\n\n"+
+			"Plan again to review the changes made outside Terraform, and apply the new plan.",
+	)
+}
+
+func ReadOnlyError(action string, typeName string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Provider Is Read-Only",
+		"The provider is configured with `read_only = true`, so it cannot "+action+" this "+
+			typeName+". No request was made to the Hund API. Reading resources and data "+
+			"sources is unaffected; to make changes, unset `read_only` in the provider "+
+			"configuration.",
+	)
+}
@@ -0,0 +1,663 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// ExportOptions configures an Export of a Hund status page.
+type ExportOptions struct {
+	Domain string
+	Key    string
+
+	// IncludeIssues additionally exports every unresolved Issue.
+	IncludeIssues bool
+}
+
+// Export writes Terraform configuration describing the Groups, Components
+// (with their Watchdogs), MetricProviders and IssueTemplates of a status page
+// to w, along with an import block for each resource, so that a status page
+// built by hand can be brought under management with a single plan and apply.
+//
+// Nothing is written to w if any error is encountered.
+func Export(ctx context.Context, version string, w io.Writer, opts ExportOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := newProviderClient(version, domainEndpoint(opts.Domain), opts.Key)
+	if err != nil {
+		diags.AddError(
+			"Unable to Create Hund API Client",
+			"An unexpected error occurred when creating the Hund API client.\n\n"+
+				"Hund Client Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	e := newExporter(ctx, client)
+
+	e.exportGroupsAndComponents()
+	e.exportMetricProviders()
+	e.exportIssueTemplates()
+
+	if opts.IncludeIssues {
+		e.exportIssues()
+	}
+
+	if e.diags.HasError() {
+		return e.diags
+	}
+
+	_, err = fmt.Fprintf(w, "# Generated by terraform-provider-hund %s export from %s.\n\n", version, opts.Domain)
+	if err == nil {
+		_, err = w.Write(hclwrite.Format(e.file.Bytes()))
+	}
+
+	if err != nil {
+		e.diags.AddError(
+			"Unable to Write Exported Configuration",
+			err.Error(),
+		)
+	}
+
+	return e.diags
+}
+
+// exporter accumulates the configuration of each exported resource.
+type exporter struct {
+	ctx    context.Context
+	client *hundApiV1.Client
+
+	file    *hclwrite.File
+	schemas map[string]schema.Schema
+
+	// names tracks the resource names taken within each resource type.
+	names map[string]map[string]bool
+
+	// refs maps the ID of each exported object to the expression which refers
+	// to it, so that dependent resources refer to it rather than to its ID.
+	refs map[string]hcl.Traversal
+
+	// watchdogs maps the ID of each exported Watchdog to the name of the
+	// hund_component which contains it.
+	watchdogs map[string]string
+
+	diags diag.Diagnostics
+}
+
+func newExporter(ctx context.Context, client *hundApiV1.Client) *exporter {
+	e := &exporter{
+		ctx:       ctx,
+		client:    client,
+		file:      hclwrite.NewEmptyFile(),
+		schemas:   map[string]schema.Schema{},
+		names:     map[string]map[string]bool{},
+		refs:      map[string]hcl.Traversal{},
+		watchdogs: map[string]string{},
+	}
+
+	for _, newResource := range []func() resource.Resource{
+		NewGroupResource,
+		NewComponentResource,
+		NewMetricProviderResource,
+		NewIssueTemplateResource,
+		NewIssueResource,
+	} {
+		r := newResource()
+
+		metadata := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "hund"}, &metadata)
+
+		rsp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &rsp)
+		e.diags.Append(rsp.Diagnostics...)
+
+		e.schemas[metadata.TypeName] = rsp.Schema
+	}
+
+	return e
+}
+
+func (e *exporter) exportGroupsAndComponents() {
+	groups := retrieveGroups(e.ctx, e.client, &e.diags)
+	if e.diags.HasError() {
+		return
+	}
+
+	for _, group := range groups {
+		model, diag0 := models.ToGroupModel(group)
+		e.diags.Append(diag0...)
+
+		if e.diags.HasError() {
+			return
+		}
+
+		importedI18nAttributes(&model.Name, &model.NameTranslations)
+		importedI18nAttributes(&model.Description, &model.DescriptionTranslations)
+
+		e.writeResource("hund_group", i18nStringOriginal(group.Name), group.Id, model)
+
+		components := retrieveGroupComponents(e.ctx, e.client, group.Id, &e.diags, hundApiV1.Expand("data.watchdog"))
+		if e.diags.HasError() {
+			return
+		}
+
+		// Export Components in the order in which they appear in their Group.
+		for _, id := range exportComponentOrder(model.Components, components) {
+			e.exportComponent(components[id])
+		}
+	}
+}
+
+func (e *exporter) exportComponent(component hundApiV1.ComponentExpansionary) {
+	model, diag0 := models.ToComponentModel(e.ctx, component)
+	e.diags.Append(diag0...)
+
+	if e.diags.HasError() {
+		return
+	}
+
+	importedI18nAttributes(&model.Name, &model.NameTranslations)
+	importedI18nAttributes(&model.Description, &model.DescriptionTranslations)
+
+	name := e.writeResource("hund_component", i18nStringOriginal(component.Name), component.Id, model)
+
+	if model.Watchdog != nil {
+		e.watchdogs[model.Watchdog.Id.ValueString()] = name
+		e.refs[model.Watchdog.Id.ValueString()] = hcl.Traversal{
+			hcl.TraverseRoot{Name: "hund_component"},
+			hcl.TraverseAttr{Name: name},
+			hcl.TraverseAttr{Name: "watchdog"},
+			hcl.TraverseAttr{Name: "id"},
+		}
+	}
+}
+
+func (e *exporter) exportMetricProviders() {
+	if e.diags.HasError() {
+		return
+	}
+
+	metricProviders := retrieveMetricProviders(e.ctx, e.client, &e.diags)
+	if e.diags.HasError() {
+		return
+	}
+
+	for _, metricProvider := range metricProviders {
+		component, ok := e.watchdogs[metricProvider.Watchdog]
+		if !ok {
+			continue
+		}
+
+		model, diag0 := models.ToMetricProviderModel(metricProvider)
+		e.diags.Append(diag0...)
+
+		if e.diags.HasError() {
+			return
+		}
+
+		for slug, instance := range model.Instances {
+			importedI18nAttributes(&instance.Title, &instance.TitleTranslations)
+			importedI18nAttributes(&instance.XTitle, &instance.XTitleTranslations)
+			importedI18nAttributes(&instance.YTitle, &instance.YTitleTranslations)
+
+			model.Instances[slug] = instance
+		}
+
+		name := component
+		if metricProvider.Default {
+			name += "_default"
+		}
+
+		e.writeResource("hund_metric_provider", name, metricProvider.Id, model)
+	}
+}
+
+func (e *exporter) exportIssueTemplates() {
+	if e.diags.HasError() {
+		return
+	}
+
+	templates := retrieveIssueTemplates(e.ctx, e.client, &e.diags)
+	if e.diags.HasError() {
+		return
+	}
+
+	for _, template := range templates {
+		model, diag0 := models.ToIssueTemplateModel(template)
+		e.diags.Append(diag0...)
+
+		if e.diags.HasError() {
+			return
+		}
+
+		importedI18nAttributes(&model.Title, &model.TitleTranslations)
+		importedI18nAttributes(&model.Body, &model.BodyTranslations)
+
+		e.writeResource("hund_issue_template", template.Name, template.Id, model)
+	}
+}
+
+func (e *exporter) exportIssues() {
+	if e.diags.HasError() {
+		return
+	}
+
+	issues := retrieveUnresolvedIssues(e.ctx, e.client, &e.diags)
+	if e.diags.HasError() {
+		return
+	}
+
+	for _, issue := range issues {
+		model, diag0 := models.ToIssueModel(e.ctx, issue)
+		e.diags.Append(diag0...)
+
+		if e.diags.HasError() {
+			return
+		}
+
+		// The applied template is already rendered into the title, body and
+		// each Update, which are exported instead.
+		model.Template = nil
+
+		importedI18nAttributes(&model.Title, &model.TitleTranslations)
+		importedI18nAttributes(&model.Body, &model.BodyTranslations)
+
+		if model.Schedule != nil {
+			model.BeganAt = types.StringNull()
+		}
+
+		if len(model.Updates) > 0 {
+			model.EndedAt = types.StringNull()
+		}
+
+		for i := range model.Updates {
+			model.Updates[i].Template = nil
+
+			importedI18nAttributes(&model.Updates[i].Body, &model.Updates[i].BodyTranslations)
+		}
+
+		e.writeResource("hund_issue", i18nStringOriginal(issue.Title), issue.Id, model)
+	}
+}
+
+// writeResource appends an import block and a resource block for the given
+// model to the exported configuration, returning the name of the resource.
+func (e *exporter) writeResource(typeName string, name string, id string, model any) string {
+	s := e.schemas[typeName]
+
+	state := tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(e.ctx), nil),
+	}
+
+	e.diags.Append(state.Set(e.ctx, model)...)
+
+	if e.diags.HasError() {
+		return ""
+	}
+
+	name = e.resourceName(typeName, name)
+	address := hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: name},
+	}
+
+	body := e.file.Body()
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", address)
+	importBody.SetAttributeValue("id", cty.StringVal(id))
+
+	body.AppendNewline()
+
+	resourceBody := body.AppendNewBlock("resource", []string{typeName, name}).Body()
+
+	for _, attribute := range exportAttributeTokens(s.Attributes, state.Raw, e.refs) {
+		resourceBody.SetAttributeRaw(string(attribute.Name.Bytes()), attribute.Value)
+	}
+
+	body.AppendNewline()
+
+	e.refs[id] = append(address, hcl.TraverseAttr{Name: "id"})
+
+	return name
+}
+
+// resourceName returns a unique resource name of the given type, derived from
+// the name of the exported object.
+func (e *exporter) resourceName(typeName string, name string) string {
+	if e.names[typeName] == nil {
+		e.names[typeName] = map[string]bool{}
+	}
+
+	base := exportResourceName(name)
+	name = base
+
+	for i := 2; e.names[typeName][name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	e.names[typeName][name] = true
+
+	return name
+}
+
+var exportResourceNameRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// exportResourceName converts the name of a Hund object into a valid
+// Terraform resource name.
+func exportResourceName(name string) string {
+	name = exportResourceNameRegexp.ReplaceAllString(strings.ToLower(name), "_")
+	name = strings.Trim(name, "_")
+
+	if name == "" {
+		return "unnamed"
+	}
+
+	if name[0] >= '0' && name[0] <= '9' {
+		return "_" + name
+	}
+
+	return name
+}
+
+// exportComponentOrder returns the IDs of the given Components in the order
+// given by the Group, followed by any others in order of ID.
+func exportComponentOrder(ordering types.List, components map[string]hundApiV1.ComponentExpansionary) []string {
+	result := []string{}
+	seen := map[string]bool{}
+
+	for _, elem := range ordering.Elements() {
+		id, ok := elem.(types.String)
+		if !ok {
+			continue
+		}
+
+		if _, ok := components[id.ValueString()]; ok && !seen[id.ValueString()] {
+			result = append(result, id.ValueString())
+			seen[id.ValueString()] = true
+		}
+	}
+
+	rest := []string{}
+
+	for id := range components {
+		if !seen[id] {
+			rest = append(rest, id)
+		}
+	}
+
+	sort.Strings(rest)
+
+	return append(result, rest...)
+}
+
+// exportAttributeTokens renders each configurable, non-null attribute of the
+// given object value, sorted by name. Computed-only and sensitive attributes
+// are left out.
+func exportAttributeTokens(attributes map[string]schema.Attribute, value tftypes.Value, refs map[string]hcl.Traversal) []hclwrite.ObjectAttrTokens {
+	values := map[string]tftypes.Value{}
+
+	if err := value.As(&values); err != nil {
+		return nil
+	}
+
+	names := []string{}
+
+	for name, attribute := range attributes {
+		if attribute.IsSensitive() || !(attribute.IsRequired() || attribute.IsOptional()) {
+			continue
+		}
+
+		if v, ok := values[name]; !ok || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	result := []hclwrite.ObjectAttrTokens{}
+
+	for _, name := range names {
+		result = append(result, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(name),
+			Value: exportAttributeValueTokens(attributes[name], values[name], refs),
+		})
+	}
+
+	return result
+}
+
+func exportAttributeValueTokens(attribute schema.Attribute, value tftypes.Value, refs map[string]hcl.Traversal) hclwrite.Tokens {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return hclwrite.TokensForObject(exportAttributeTokens(a.Attributes, value, refs))
+	case schema.ListNestedAttribute:
+		return exportNestedElementTokens(a.NestedObject.Attributes, value, refs)
+	case schema.SetNestedAttribute:
+		return exportNestedElementTokens(a.NestedObject.Attributes, value, refs)
+	case schema.MapNestedAttribute:
+		elems := map[string]tftypes.Value{}
+
+		if err := value.As(&elems); err != nil {
+			return nil
+		}
+
+		result := []hclwrite.ObjectAttrTokens{}
+
+		for _, key := range sortedKeys(elems) {
+			result = append(result, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: hclwrite.TokensForObject(exportAttributeTokens(a.NestedObject.Attributes, elems[key], refs)),
+			})
+		}
+
+		return hclwrite.TokensForObject(result)
+	}
+
+	return exportValueTokens(value, refs)
+}
+
+func exportNestedElementTokens(attributes map[string]schema.Attribute, value tftypes.Value, refs map[string]hcl.Traversal) hclwrite.Tokens {
+	elems := []tftypes.Value{}
+
+	if err := value.As(&elems); err != nil {
+		return nil
+	}
+
+	result := []hclwrite.Tokens{}
+
+	for _, elem := range elems {
+		result = append(result, hclwrite.TokensForObject(exportAttributeTokens(attributes, elem, refs)))
+	}
+
+	return hclwrite.TokensForTuple(result)
+}
+
+// exportValueTokens renders a value which is not described by nested
+// attributes. Strings which match the ID of an exported object are rendered
+// as references to that object.
+func exportValueTokens(value tftypes.Value, refs map[string]hcl.Traversal) hclwrite.Tokens {
+	if value.IsNull() || !value.IsKnown() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))
+	}
+
+	typ := value.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+
+		if ref, ok := refs[s]; ok {
+			return hclwrite.TokensForTraversal(ref)
+		}
+
+		return hclwrite.TokensForValue(cty.StringVal(s))
+	case typ.Is(tftypes.Number):
+		f := new(big.Float)
+		_ = value.As(&f)
+
+		return hclwrite.TokensForValue(cty.NumberVal(f))
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+
+		return hclwrite.TokensForValue(cty.BoolVal(b))
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		elems := []tftypes.Value{}
+		_ = value.As(&elems)
+
+		result := []hclwrite.Tokens{}
+
+		for _, elem := range elems {
+			result = append(result, exportValueTokens(elem, refs))
+		}
+
+		return hclwrite.TokensForTuple(result)
+	default:
+		elems := map[string]tftypes.Value{}
+		_ = value.As(&elems)
+
+		result := []hclwrite.ObjectAttrTokens{}
+
+		for _, key := range sortedKeys(elems) {
+			result = append(result, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: exportValueTokens(elems[key], refs),
+			})
+		}
+
+		return hclwrite.TokensForObject(result)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func retrieveMetricProviders(ctx context.Context, client *hundApiV1.Client, diags *diag.Diagnostics) []hundApiV1.MetricProvider {
+	result := []hundApiV1.MetricProvider{}
+
+	limit := 100
+	params := hundApiV1.GetAllMetricProvidersParams{
+		Limit: &limit,
+	}
+
+	for {
+		rsp, err := client.GetAllMetricProviders(ctx, &params)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund MetricProviders",
+				err.Error(),
+			)
+			return nil
+		}
+
+		metricProviders, err := hundApiV1.ParseGetAllMetricProvidersResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund MetricProviders",
+				err.Error(),
+			)
+			return nil
+		}
+
+		if metricProviders.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(metricProviders.StatusCode())+
+					"\nError: "+string(metricProviders.Body),
+			)
+			return nil
+		}
+
+		result = append(result, metricProviders.HALJSON200.Data...)
+
+		if !metricProviders.HALJSON200.HasMore || len(metricProviders.HALJSON200.Data) == 0 {
+			return result
+		}
+
+		params.StartingAfter = &metricProviders.HALJSON200.Data[len(metricProviders.HALJSON200.Data)-1].Id
+	}
+}
+
+func retrieveUnresolvedIssues(ctx context.Context, client *hundApiV1.Client, diags *diag.Diagnostics) []hundApiV1.Issue {
+	result := []hundApiV1.Issue{}
+
+	limit := 100
+	resolved := false
+	params := hundApiV1.GetAllIssuesParams{
+		Resolved: &resolved,
+		Limit:    &limit,
+	}
+
+	for {
+		rsp, err := client.GetAllIssues(ctx, &params)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund Issues",
+				err.Error(),
+			)
+			return nil
+		}
+
+		issues, err := hundApiV1.ParseGetAllIssuesResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund Issues",
+				err.Error(),
+			)
+			return nil
+		}
+
+		if issues.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(issues.StatusCode())+
+					"\nError: "+string(issues.Body),
+			)
+			return nil
+		}
+
+		for _, issue := range issues.HALJSON200.Data {
+			if !issue.Resolved {
+				result = append(result, issue)
+			}
+		}
+
+		if !issues.HALJSON200.HasMore || len(issues.HALJSON200.Data) == 0 {
+			return result
+		}
+
+		params.StartingAfter = &issues.HALJSON200.Data[len(issues.HALJSON200.Data)-1].Id
+	}
+}
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+func TestExportResourceName(t *testing.T) {
+	cases := map[string]string{
+		"Core Services":  "core_services",
+		"API (v2) — EU ": "api_v2_eu",
+		"1st Party":      "_1st_party",
+		"???":            "unnamed",
+	}
+
+	for name, expected := range cases {
+		if actual := exportResourceName(name); actual != expected {
+			t.Errorf("exportResourceName(%q): expected %q, got %q", name, expected, actual)
+		}
+	}
+}
+
+func TestExporterWriteResource(t *testing.T) {
+	e := newExporter(context.Background(), nil)
+
+	if e.diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", e.diags)
+	}
+
+	group := models.GroupModel{
+		Id:                          types.StringValue("5d72d51f8fbb65b5d3a587e1"),
+		CreatedAt:                   types.StringValue("2024-01-01T00:00:00Z"),
+		UpdatedAt:                   types.StringValue("2024-01-01T00:00:00Z"),
+		Name:                        types.StringValue("Core Services"),
+		NameTranslations:            types.MapNull(types.StringType),
+		DescriptionTranslations:     types.MapNull(types.StringType),
+		DescriptionHtmlTranslations: types.MapNull(types.StringType),
+		Collapsed:                   types.BoolValue(false),
+		Position:                    types.Int64Value(1),
+		Components:                  types.ListNull(types.StringType),
+	}
+
+	component := models.ComponentModel{
+		Id:                          types.StringValue("5d72d51f8fbb65b5d3a587e2"),
+		Name:                        types.StringValue("API"),
+		NameTranslations:            types.MapNull(types.StringType),
+		DescriptionTranslations:     types.MapNull(types.StringType),
+		DescriptionHtmlTranslations: types.MapNull(types.StringType),
+		Group:                       types.StringValue("5d72d51f8fbb65b5d3a587e1"),
+		ExcludeFromGlobalHistory:    types.BoolValue(true),
+	}
+
+	e.writeResource("hund_group", "Core Services", group.Id.ValueString(), group)
+	e.writeResource("hund_group", "Core Services", "5d72d51f8fbb65b5d3a587e3", group)
+	e.writeResource("hund_component", "API", component.Id.ValueString(), component)
+
+	if e.diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", e.diags)
+	}
+
+	// Ignore the alignment of attributes.
+	actual := strings.Join(strings.Fields(string(e.file.Bytes())), " ")
+
+	for _, expected := range []string{
+		"import { to = hund_group.core_services id = \"5d72d51f8fbb65b5d3a587e1\" }",
+		"resource \"hund_group\" \"core_services\" { collapsed = false name = \"Core Services\" position = 1 }",
+		"resource \"hund_group\" \"core_services_2\" {",
+		"resource \"hund_component\" \"api\" { exclude_from_global_history = true group = hund_group.core_services.id name = \"API\" }",
+	} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("expected exported configuration to contain %q, got:\n%s", expected, actual)
+		}
+	}
+
+	for _, unexpected := range []string{
+		"created_at",
+		"components",
+		"description",
+	} {
+		if strings.Contains(actual, unexpected) {
+			t.Errorf("expected exported configuration not to contain %q, got:\n%s", unexpected, actual)
+		}
+	}
+}
+
+func TestExporterComponentWatchdog(t *testing.T) {
+	server := hundtest.NewServer()
+	defer server.Close()
+
+	client, err := newProviderClient("test", server.Endpoint(), "hundtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, component := testExportCreateComponent(t, client, "Core Services")
+
+	e := newExporter(context.Background(), client)
+	e.exportGroupsAndComponents()
+	e.exportMetricProviders()
+
+	if e.diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", e.diags)
+	}
+
+	// Ignore the alignment of attributes.
+	actual := strings.Join(strings.Fields(string(e.file.Bytes())), " ")
+
+	for _, expected := range []string{
+		fmt.Sprintf("import { to = hund_group.core_services id = %q }", group),
+		fmt.Sprintf("import { to = hund_component.api id = %q }", component.Id),
+		fmt.Sprintf("import { to = hund_metric_provider.api_default id = %q }", testExportDefaultMetricProvider(t, client, component)),
+		"watchdog = { high_frequency = false service = { icmp = {",
+		"regions = [\"wa-us-1\"] target = \"example.com\" timeout = 15000 } } }",
+		"resource \"hund_metric_provider\" \"api_default\" { default = true",
+		"watchdog = hund_component.api.watchdog.id",
+	} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("expected exported configuration to contain %q, got:\n%s", expected, actual)
+		}
+	}
+}
+
+// TestAccExport_importPlansNoChanges plans the configuration exported from a
+// status page, which should import each resource, including the default
+// MetricProvider of each Watchdog, without any changes.
+func TestAccExport_importPlansNoChanges(t *testing.T) {
+	dir := t.TempDir()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client, err := sharedClientForDomain("")
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					group, component := testExportCreateComponent(t, client, t.Name())
+
+					// The configuration is only planned, so nothing is destroyed.
+					t.Cleanup(func() {
+						ctx := context.Background()
+
+						if _, err := client.DeleteAComponent(ctx, component.Id); err != nil {
+							t.Error(err)
+						}
+
+						if _, err := client.DeleteAGroup(ctx, group); err != nil {
+							t.Error(err)
+						}
+					})
+
+					e := newExporter(context.Background(), client)
+					e.exportGroupsAndComponents()
+					e.exportMetricProviders()
+
+					if e.diags.HasError() {
+						t.Fatalf("unexpected diagnostics: %v", e.diags)
+					}
+
+					if err := os.WriteFile(filepath.Join(dir, "provider.tf"), []byte(providerConfig), 0o644); err != nil {
+						t.Fatal(err)
+					}
+
+					if err := os.WriteFile(filepath.Join(dir, "export.tf"), hclwrite.Format(e.file.Bytes()), 0o644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				ConfigDirectory: func(config.TestStepConfigRequest) string { return dir },
+				PlanOnly:        true,
+			},
+		},
+	})
+}
+
+// testExportCreateComponent creates a Group with the given name, containing a
+// Component with an ICMP Watchdog. Every attribute of the service is given, as the hundtest fake does not fill
+// in the defaults of the Hund API.
+func testExportCreateComponent(t *testing.T, client *hundApiV1.Client, groupName string) (string, hundApiV1.ComponentExpansionary) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	group := testFixtureCreateGroup(t, client, fmt.Sprintf(`{"name": %q}`, groupName))
+
+	service := hundApiV1.FormWatchdogCreate{}
+	if err := service.UnmarshalJSON([]byte(`{
+		"type": "native", "method": "icmp", "target": "example.com", "regions": ["wa-us-1"],
+		"ip_version": "inet", "frequency": 60000, "timeout": 15000,
+		"consecutive_check_outage_threshold": 1,
+		"percentage_failed_threshold": 0.5, "percentage_regions_failed_threshold": 0.5
+	}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	name := hundApiV1.I18nString{}
+	if err := name.FromI18nString0("API"); err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := client.CreateAComponent(ctx, hundApiV1.ComponentFormCreate{
+		Group:    group.Id,
+		Name:     name,
+		Watchdog: hundApiV1.WatchdogFormCreate{Service: service},
+	}, hundApiV1.Expand("watchdog"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	component, err := hundApiV1.ParseCreateAComponentResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if component.StatusCode() != 201 {
+		t.Fatalf("expected to create a component, got %d: %s", component.StatusCode(), component.Body)
+	}
+
+	return group.Id, *component.HALJSON201
+}
+
+// testExportDefaultMetricProvider returns the ID of the default
+// MetricProvider of the Watchdog of the given Component.
+func testExportDefaultMetricProvider(t *testing.T, client *hundApiV1.Client, component hundApiV1.ComponentExpansionary) string {
+	t.Helper()
+
+	ctx := context.Background()
+
+	componentModel, diags := models.ToComponentModel(ctx, component)
+	if diags.HasError() || componentModel.Watchdog == nil {
+		t.Fatalf("expected a component with a watchdog, got %v", diags)
+	}
+
+	r := &MetricProviderResource{client: client}
+
+	model := r.findDefaultMetricProvider(ctx, componentModel.Watchdog.Id.ValueString(), &diags)
+	if model == nil || diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return model.Id.ValueString()
+}
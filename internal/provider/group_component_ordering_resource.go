@@ -3,14 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,9 +34,9 @@ import (
 var _ resource.Resource = &GroupComponentOrderingResource{}
 var _ resource.ResourceWithConfigure = &GroupComponentOrderingResource{}
 var _ resource.ResourceWithImportState = &GroupComponentOrderingResource{}
+var _ resource.ResourceWithIdentity = &GroupComponentOrderingResource{}
 var _ resource.ResourceWithConfigValidators = &GroupComponentOrderingResource{}
-
-// var _ resource.ResourceWithModifyPlan = &GroupComponentOrderingResource{}
+var _ resource.ResourceWithModifyPlan = &GroupComponentOrderingResource{}
 
 func NewGroupComponentOrderingResource() resource.Resource {
 	return &GroupComponentOrderingResource{}
@@ -35,9 +44,26 @@ func NewGroupComponentOrderingResource() resource.Resource {
 
 // GroupComponentOrderingResource defines the resource implementation.
 type GroupComponentOrderingResource struct {
-	client *hundApiV1.Client
+	client     *hundApiV1.Client
+	groupMutex *MutexKV
+
+	readOnly bool
 }
 
+// groupComponentOrderingAttempts bounds the number of times a reordering is
+// retried when the Components of its Group change concurrently.
+const groupComponentOrderingAttempts = 5
+
+const (
+	orderingModeExact  = "exact"
+	orderingModePrefix = "prefix"
+)
+
+const (
+	groupComponentOrderingByName      = "name"
+	groupComponentOrderingByCreatedAt = "created_at"
+)
+
 // GroupComponentOrderingResourceModel describes the resource data model.
 type GroupComponentOrderingResourceModel models.GroupComponentOrderingModel
 
@@ -61,8 +87,17 @@ func (r *GroupComponentOrderingResource) Schema(ctx context.Context, req resourc
 				MarkdownDescription: "The Group whose ordering is managed by this resource.",
 				Required:            true,
 			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "How `components` is applied to the Group. In `exact` mode, `components` lists every Component in the Group. In `prefix` mode, only the listed Components are placed, in order, at the top of the Group, and the remaining Components keep their relative order beneath them. Defaults to `exact`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(orderingModeExact),
+				Validators: []validator.String{
+					stringvalidator.OneOf(orderingModeExact, orderingModePrefix),
+				},
+			},
 			"components": schema.ListAttribute{
-				MarkdownDescription: "The list of Component IDs in this Group, listed in the exact order they will appear under the Group.\n\n~> This list **must not** omit nor add any Components not already in the referenced Group, or an error will occur. This resource is **only** for managing an order for the Components of a Group.",
+				MarkdownDescription: "The list of Component IDs in this Group, listed in the exact order they will appear under the Group.\n\n~> In `exact` mode, this list **must not** omit nor add any Components not already in the referenced Group, or an error will occur. In `prefix` mode, this list may omit Components, but **must not** add any Components not already in the referenced Group. This resource is **only** for managing an order for the Components of a Group.\n\n-> Changes to the Components of a Group made by this provider are serialized per Group, and a reordering is retried briefly when the Group's Components change concurrently.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
@@ -73,12 +108,93 @@ func (r *GroupComponentOrderingResource) Schema(ctx context.Context, req resourc
 					listvalidator.UniqueValues(),
 				},
 			},
+			"order_by": schema.SingleNestedAttribute{
+				MarkdownDescription: "A rule from which the ordering of **all** Components in the Group is computed, in place of listing them in `components`. Components are sorted first by their `weights` (if any), then by `attribute` (if any), and otherwise keep their current relative order.\n\n-> The ordering is computed from the Components in the Group at the time of planning and applying. A Component added to the Group later (or in the same apply) will cause the Group to be reported out of order on the next plan.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"attribute": schema.StringAttribute{
+						MarkdownDescription: "The Component attribute by which to sort, one of `name` or `created_at`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(groupComponentOrderingByName, groupComponentOrderingByCreatedAt),
+						},
+					},
+					"locale": schema.StringAttribute{
+						MarkdownDescription: "When sorting by `name`, sort by the translation of the name in this locale (e.g. `de`). Components without a translation in this locale are sorted by their original name.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("attribute")),
+						},
+					},
+					"descending": schema.BoolAttribute{
+						MarkdownDescription: "Whether to sort by `attribute` in descending order. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"weights": schema.MapAttribute{
+						MarkdownDescription: "A Map of Component IDs to explicit weights. Components with a lower weight are placed first. Components not in this map have a weight of `0`.",
+						Optional:            true,
+						ElementType:         types.Int64Type,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AtLeastOneOf(
+						path.MatchRelative().AtName("attribute"),
+						path.MatchRelative().AtName("weights"),
+					),
+				},
+			},
 		},
 	}
 }
 
 func (r *GroupComponentOrderingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
-	return []resource.ConfigValidator{}
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("order_by"),
+			path.MatchRoot("components"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("order_by"),
+			path.MatchRoot("mode"),
+		),
+	}
+}
+
+func (r *GroupComponentOrderingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan GroupComponentOrderingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.OrderBy == nil {
+		return
+	}
+
+	if plan.Group.IsUnknown() || plan.OrderBy.Weights.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("components"), types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	// Report the Group as out of order when its current ordering differs from
+	// the one computed by order_by.
+	ordering := r.sortedGroupComponentOrdering(ctx, plan.Group.ValueString(), *plan.OrderBy, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !slices.Equal(ordering, stringValues(plan.Components)) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("components"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+func (r *GroupComponentOrderingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ObjectId of the Group whose Components are ordered.")
 }
 
 func (r *GroupComponentOrderingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -87,21 +203,31 @@ func (r *GroupComponentOrderingResource) Configure(ctx context.Context, req reso
 		return
 	}
 
-	client, ok := req.ProviderData.(*hundApiV1.Client)
+	data, ok := req.ProviderData.(*HundResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hundApiV1.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.groupMutex = data.GroupMutex
 }
 
 func (r *GroupComponentOrderingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group_component_ordering", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_group_component_ordering"))
+		return
+	}
+
 	var data GroupComponentOrderingResourceModel
 
 	// Read Terraform plan data into the model
@@ -123,9 +249,13 @@ func (r *GroupComponentOrderingResource) Create(ctx context.Context, req resourc
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *GroupComponentOrderingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group_component_ordering", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	var data GroupComponentOrderingResourceModel
 
 	// Read Terraform prior state data into the model
@@ -135,50 +265,42 @@ func (r *GroupComponentOrderingResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	rsp, err := r.client.RetrieveAGroup(ctx, data.Group.ValueString(), hundApiV1.Unexpand("components"))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Hund Group",
-			err.Error(),
-		)
+	newState, found := r.retrieveGroupComponentOrdering(ctx, data.Group.ValueString(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if rsp.StatusCode == 404 {
+	if !found {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	group, err := hundApiV1.ParseRetrieveAGroupResponse(rsp)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Parse Hund Group",
-			err.Error(),
-		)
-		return
-	}
+	newState.Mode = data.Mode
+	newState.OrderBy = data.OrderBy
 
-	if group.StatusCode() != 200 {
-		resp.Diagnostics.AddError(
-			"Failed response code from Hund API",
-			"Received a non-200 status code: "+fmt.Sprint(group.StatusCode())+
-				"\nError: "+string(group.Body),
-		)
-		return
+	if newState.Mode.IsNull() {
+		newState.Mode = types.StringValue(orderingModeExact)
 	}
 
-	newState, diag := models.ToGroupComponentOrderingModel(*group.HALJSON200)
-	resp.Diagnostics.Append(diag...)
-
-	if resp.Diagnostics.HasError() {
-		return
+	if newState.Mode.ValueString() == orderingModePrefix && len(data.Components) < len(newState.Components) {
+		newState.Components = newState.Components[:len(data.Components)]
 	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *GroupComponentOrderingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group_component_ordering", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_group_component_ordering"))
+		return
+	}
+
 	var data GroupComponentOrderingResourceModel
 
 	// Read Terraform plan data into the model
@@ -196,24 +318,285 @@ func (r *GroupComponentOrderingResource) Update(ctx context.Context, req resourc
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *GroupComponentOrderingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group_component_ordering", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_group_component_ordering"))
+		return
+	}
+
 	tflog.Debug(ctx, "deleting group_component_ordering")
 }
 
 func (r *GroupComponentOrderingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("group"), req, resp)
+	group := resolveGroupImportId(ctx, r.client, importIdentityId(ctx, req, &resp.Diagnostics), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), group)...)
 }
 
 func (r *GroupComponentOrderingResource) commitGroupComponentOrdering(ctx context.Context, data GroupComponentOrderingResourceModel, diags *diag.Diagnostics) *models.GroupComponentOrderingModel {
+	requested := stringValues(data.Components)
+
+	prefix := data.Mode.ValueString() == orderingModePrefix
+
+	for attempt := 1; ; attempt++ {
+		unlock := r.groupMutex.LockAll(data.Group.ValueString())
+
+		ordering := requested
+		if data.OrderBy != nil {
+			ordering = r.sortedGroupComponentOrdering(ctx, data.Group.ValueString(), *data.OrderBy, diags)
+		} else if prefix {
+			ordering = r.prefixGroupComponentOrdering(ctx, data.Group.ValueString(), requested, diags)
+		}
+
+		var group *hundApiV1.ReorderAGroupsComponentsResponse
+		if !diags.HasError() {
+			group = r.reorderGroupComponents(ctx, data.Group.ValueString(), ordering, diags)
+		}
+
+		unlock()
+
+		if diags.HasError() {
+			return nil
+		}
+
+		if group.StatusCode() == 200 {
+			newState, diag := models.ToGroupComponentOrderingModel(*group.HALJSON200)
+			diags.Append(diag...)
+
+			newState.Mode = data.Mode
+			newState.OrderBy = data.OrderBy
+
+			if prefix && len(requested) < len(newState.Components) {
+				newState.Components = newState.Components[:len(requested)]
+			}
+
+			return &newState
+		}
+
+		// The API rejects orderings which do not contain exactly the Components
+		// of the Group. If the Group's Components are changing underneath us
+		// (e.g. a Component is concurrently being deleted), try again shortly.
+		if group.StatusCode() == 400 && attempt < groupComponentOrderingAttempts {
+			current, found := r.retrieveGroupComponentOrdering(ctx, data.Group.ValueString(), diags)
+
+			if diags.HasError() {
+				return nil
+			}
+
+			if found && !sameComponentSet(current.Components, ordering) {
+				tflog.Debug(ctx, "components of group changed concurrently, retrying reorder", map[string]interface{}{
+					"group":   data.Group.ValueString(),
+					"attempt": attempt,
+				})
+
+				select {
+				case <-ctx.Done():
+					diags.AddError(
+						"Unable to Reorder Hund Group's Components",
+						ctx.Err().Error(),
+					)
+					return nil
+				case <-time.After(time.Duration(attempt) * time.Second):
+				}
+
+				continue
+			}
+		}
+
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-200 status code: "+fmt.Sprint(group.StatusCode())+
+				"\nError: "+string(group.Body),
+		)
+		return nil
+	}
+}
+
+// prefixGroupComponentOrdering computes the full ordering of the Group's
+// Components, with the given Components placed first, and the remaining
+// Components following in their current relative order.
+func (r *GroupComponentOrderingResource) prefixGroupComponentOrdering(ctx context.Context, groupId string, pinned []string, diags *diag.Diagnostics) []string {
+	current, found := r.retrieveGroupComponentOrdering(ctx, groupId, diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	if !found {
+		diags.AddAttributeError(
+			path.Root("group"),
+			"Group ID Does not Exist",
+			"A group_component_ordering requires an existing Group. The given ID was not found.",
+		)
+		return nil
+	}
+
 	ordering := []string{}
+	rest := []string{}
+
+	for _, component := range current.Components {
+		if !slices.Contains(pinned, component.ValueString()) {
+			rest = append(rest, component.ValueString())
+		}
+	}
+
+	for _, component := range pinned {
+		if !slices.ContainsFunc(current.Components, func(c types.String) bool { return c.ValueString() == component }) {
+			diags.AddAttributeError(
+				path.Root("components"),
+				"Component Not in Group",
+				"The Component "+component+" is not in the Group "+groupId+". "+
+					"A group_component_ordering can only order Components which are already in its Group.",
+			)
+		}
+
+		ordering = append(ordering, component)
+	}
+
+	return append(ordering, rest...)
+}
+
+// sortedGroupComponentOrdering computes the full ordering of the Group's
+// Components according to the given rule.
+func (r *GroupComponentOrderingResource) sortedGroupComponentOrdering(ctx context.Context, groupId string, rule models.GroupComponentOrderingRuleModel, diags *diag.Diagnostics) []string {
+	current, found := r.retrieveGroupComponentOrdering(ctx, groupId, diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	if !found {
+		diags.AddAttributeError(
+			path.Root("group"),
+			"Group ID Does not Exist",
+			"A group_component_ordering requires an existing Group. The given ID was not found.",
+		)
+		return nil
+	}
+
+	components := retrieveGroupComponents(ctx, r.client, groupId, diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	weights := map[string]types.Int64{}
+	diags.Append(rule.Weights.ElementsAs(ctx, &weights, false)...)
+
+	if diags.HasError() {
+		return nil
+	}
 
-	for _, sv := range data.Components {
-		ordering = append(ordering, sv.ValueString())
+	ordering := stringValues(current.Components)
+
+	sortKeys := map[string]string{}
+
+	for _, id := range ordering {
+		component, ok := components[id]
+		if !ok {
+			continue
+		}
+
+		switch rule.Attribute.ValueString() {
+		case groupComponentOrderingByName:
+			name, err := component.Name.AsI18nString1()
+			if err != nil {
+				diags.AddError(
+					"Could not parse Hund I18nString",
+					"Could not sort by the name of Component "+id+". Error: "+err.Error(),
+				)
+				return nil
+			}
+
+			if translation, ok := name[rule.Locale.ValueString()]; ok && !rule.Locale.IsNull() {
+				sortKeys[id] = strings.ToLower(translation)
+			} else {
+				sortKeys[id] = strings.ToLower(name[name["original"]])
+			}
+		case groupComponentOrderingByCreatedAt:
+			sortKeys[id] = fmt.Sprintf("%020d", component.CreatedAt)
+		}
 	}
 
-	rsp, err := r.client.ReorderAGroupsComponents(ctx, data.Group.ValueString(), ordering, hundApiV1.Unexpand("components"))
+	sort.SliceStable(ordering, func(i, j int) bool {
+		wi, wj := weights[ordering[i]].ValueInt64(), weights[ordering[j]].ValueInt64()
+		if wi != wj {
+			return wi < wj
+		}
+
+		ki, kj := sortKeys[ordering[i]], sortKeys[ordering[j]]
+		if rule.Descending.ValueBool() {
+			return ki > kj
+		}
+
+		return ki < kj
+	})
+
+	return ordering
+}
+
+// retrieveGroupComponents retrieves every Component of the given Group, keyed by ID.
+func retrieveGroupComponents(ctx context.Context, client *hundApiV1.Client, groupId string, diags *diag.Diagnostics, reqEditors ...hundApiV1.RequestEditorFn) map[string]hundApiV1.ComponentExpansionary {
+	result := map[string]hundApiV1.ComponentExpansionary{}
+
+	limit := 100
+	params := hundApiV1.GetAllComponentsParams{
+		Group: &groupId,
+		Limit: &limit,
+	}
+
+	for {
+		rsp, err := client.GetAllComponents(ctx, &params, reqEditors...)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund Components",
+				err.Error(),
+			)
+			return nil
+		}
+
+		components, err := hundApiV1.ParseGetAllComponentsResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund Components",
+				err.Error(),
+			)
+			return nil
+		}
+
+		if components.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(components.StatusCode())+
+					"\nError: "+string(components.Body),
+			)
+			return nil
+		}
+
+		for _, component := range components.HALJSON200.Data {
+			result[component.Id] = component
+		}
+
+		if !components.HALJSON200.HasMore || len(components.HALJSON200.Data) == 0 {
+			return result
+		}
+
+		params.StartingAfter = &components.HALJSON200.Data[len(components.HALJSON200.Data)-1].Id
+	}
+}
+
+func (r *GroupComponentOrderingResource) reorderGroupComponents(ctx context.Context, groupId string, ordering []string, diags *diag.Diagnostics) *hundApiV1.ReorderAGroupsComponentsResponse {
+	rsp, err := r.client.ReorderAGroupsComponents(ctx, groupId, ordering, hundApiV1.Unexpand("components"))
 	if err != nil {
 		diags.AddError(
 			"Unable to Reorder Hund Group's Components",
@@ -240,17 +623,67 @@ func (r *GroupComponentOrderingResource) commitGroupComponentOrdering(ctx contex
 		return nil
 	}
 
+	return group
+}
+
+func (r *GroupComponentOrderingResource) retrieveGroupComponentOrdering(ctx context.Context, groupId string, diags *diag.Diagnostics) (*models.GroupComponentOrderingModel, bool) {
+	rsp, err := r.client.RetrieveAGroup(ctx, groupId, hundApiV1.Unexpand("components"))
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Hund Group",
+			err.Error(),
+		)
+		return nil, false
+	}
+
+	if rsp.StatusCode == 404 {
+		return nil, false
+	}
+
+	group, err := hundApiV1.ParseRetrieveAGroupResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Group",
+			err.Error(),
+		)
+		return nil, false
+	}
+
 	if group.StatusCode() != 200 {
 		diags.AddError(
 			"Failed response code from Hund API",
 			"Received a non-200 status code: "+fmt.Sprint(group.StatusCode())+
 				"\nError: "+string(group.Body),
 		)
-		return nil
+		return nil, false
 	}
 
-	newState, diag := models.ToGroupComponentOrderingModel(*group.HALJSON200)
+	ordering, diag := models.ToGroupComponentOrderingModel(*group.HALJSON200)
 	diags.Append(diag...)
 
-	return &newState
+	return &ordering, true
+}
+
+func stringValues(values []types.String) []string {
+	result := []string{}
+
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+
+	return result
+}
+
+func sameComponentSet(components []types.String, ordering []string) bool {
+	if len(components) != len(ordering) {
+		return false
+	}
+
+	for _, component := range components {
+		if !slices.Contains(ordering, component.ValueString()) {
+			return false
+		}
+	}
+
+	return true
 }
@@ -1,10 +1,21 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
+	"github.com/hundio/terraform-provider-hund/internal/models"
 )
 
 func TestAccGroupComponentOrderingResource(t *testing.T) {
@@ -14,7 +25,7 @@ func TestAccGroupComponentOrderingResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccGroupComponentOrderingResourceConfig(false),
+				Config: testAccGroupComponentOrderingResourceConfig(t.Name(), false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("hund_group_component_ordering.test", "components.#", "3"),
 					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.0", "hund_component.beta", "id"),
@@ -28,9 +39,80 @@ func TestAccGroupComponentOrderingResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by Group name testing
+			{
+				ResourceName:      "hund_group_component_ordering.test",
+				ImportState:       true,
+				ImportStateId:     t.Name(),
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
-				Config: testAccGroupComponentOrderingResourceConfig(true),
+				Config: testAccGroupComponentOrderingResourceConfig(t.Name(), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_group_component_ordering.test", "components.#", "3"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.0", "hund_component.alpha", "id"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.1", "hund_component.beta", "id"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.2", "hund_component.delta", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGroupComponentOrderingResource_concurrentComponents(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupComponentOrderingResourceConcurrentConfig(t.Name(), 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_group_component_ordering.test", "components.#", "10"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.0", "hund_component.test.9", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupComponentOrderingResource_prefix(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGroupComponentOrderingResourcePrefixConfig(t.Name(), `[hund_component.delta.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_group_component_ordering.test", "mode", "prefix"),
+					resource.TestCheckResourceAttr("hund_group_component_ordering.test", "components.#", "1"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.0", "hund_component.delta", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGroupComponentOrderingResourcePrefixConfig(t.Name(), `[hund_component.beta.id, hund_component.alpha.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_group_component_ordering.test", "components.#", "2"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.0", "hund_component.beta", "id"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.1", "hund_component.alpha", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGroupComponentOrderingResource_orderBy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGroupComponentOrderingResourceOrderByConfig(t.Name(), `{ attribute = "name" }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("hund_group_component_ordering.test", "components.#", "3"),
 					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.0", "hund_component.alpha", "id"),
@@ -38,12 +120,97 @@ func TestAccGroupComponentOrderingResource(t *testing.T) {
 					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.2", "hund_component.delta", "id"),
 				),
 			},
+			// Update and Read testing
+			{
+				Config: testAccGroupComponentOrderingResourceOrderByConfig(t.Name(), `{
+    attribute  = "name"
+    descending = true
+    weights    = { (hund_component.beta.id) = -1 }
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_group_component_ordering.test", "components.#", "3"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.0", "hund_component.beta", "id"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.1", "hund_component.delta", "id"),
+					resource.TestCheckResourceAttrPair("hund_group_component_ordering.test", "components.2", "hund_component.alpha", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
-func testAccGroupComponentOrderingResourceConfig(sortByName bool) string {
+func TestSortedGroupComponentOrderingUnparsableName(t *testing.T) {
+	server := hundtest.NewServer()
+	defer server.Close()
+
+	client, err := newProviderClient("test", server.Endpoint(), "hundtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group := testImportCreateGroup(t, client, t.Name())
+	testImportCreateComponent(t, client, group, "Alpha")
+	testImportCreateComponent(t, client, group, "Beta")
+
+	// Serve Component names which are not translated I18nStrings.
+	client, err = newTransportClient("test", server.Endpoint(), "hundtest", testComponentNameTransport{http.DefaultTransport})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &GroupComponentOrderingResource{client: client}
+
+	var diags diag.Diagnostics
+
+	ordering := r.sortedGroupComponentOrdering(context.Background(), group, models.GroupComponentOrderingRuleModel{
+		Attribute:  types.StringValue(groupComponentOrderingByName),
+		Locale:     types.StringNull(),
+		Descending: types.BoolValue(false),
+		Weights:    types.MapNull(types.Int64Type),
+	}, &diags)
+
+	if ordering != nil || !diags.HasError() || !strings.Contains(diags.Errors()[0].Summary(), "Could not parse Hund I18nString") {
+		t.Errorf("expected an unparsable name to be reported, got %v and %v", ordering, diags)
+	}
+}
+
+// testComponentNameTransport replaces the name of each listed Component with
+// a plain string.
+type testComponentNameTransport struct {
+	next http.RoundTripper
+}
+
+func (t testComponentNameTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rsp, err := t.next.RoundTrip(req)
+	if err != nil || req.Method != http.MethodGet || !strings.HasSuffix(req.URL.Path, "/components") {
+		return rsp, err
+	}
+
+	defer rsp.Body.Close()
+
+	var body map[string]any
+	if err := json.NewDecoder(rsp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	data, _ := body["data"].([]any)
+	for _, component := range data {
+		component.(map[string]any)["name"] = "Plain"
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp.Body = io.NopCloser(bytes.NewReader(encoded))
+	rsp.ContentLength = int64(len(encoded))
+	rsp.Header.Del("Content-Length")
+
+	return rsp, nil
+}
+
+func testAccGroupComponentOrderingResourceConfig(group string, sortByName bool) string {
 	var ordering string
 
 	if sortByName {
@@ -54,10 +221,11 @@ func testAccGroupComponentOrderingResourceConfig(sortByName bool) string {
 
 	return providerConfig + fmt.Sprintf(`
 resource "hund_group" "test" {
-  name = "testing group"
+  name = %[2]q
 }
 
 resource "hund_component" "beta" {
+  deletion_protection = false
   name = "Beta"
   group = resource.hund_group.test.id
 
@@ -65,6 +233,7 @@ resource "hund_component" "beta" {
 }
 
 resource "hund_component" "alpha" {
+  deletion_protection = false
   name = "Alpha"
   group = resource.hund_group.test.id
 
@@ -72,6 +241,7 @@ resource "hund_component" "alpha" {
 }
 
 resource "hund_component" "delta" {
+  deletion_protection = false
   name = "Delta"
   group = resource.hund_group.test.id
 
@@ -83,5 +253,110 @@ resource "hund_group_component_ordering" "test" {
 
   components = %[1]v
 }
-`, ordering)
+`, ordering, group)
+}
+
+func testAccGroupComponentOrderingResourceConcurrentConfig(group string, count int) string {
+	return providerConfig + fmt.Sprintf(`
+resource "hund_group" "test" {
+  name = %[2]q
+}
+
+resource "hund_component" "test" {
+  deletion_protection = false
+  count = %[1]v
+
+  name = "Component ${count.index}"
+  group = resource.hund_group.test.id
+
+  watchdog = { service = { manual = {} } }
+}
+
+resource "hund_group_component_ordering" "test" {
+  group = resource.hund_group.test.id
+
+  components = reverse(hund_component.test[*].id)
+}
+`, count, group)
+}
+
+func testAccGroupComponentOrderingResourcePrefixConfig(group string, pinned string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "hund_group" "test" {
+  name = %[2]q
+}
+
+resource "hund_component" "alpha" {
+  deletion_protection = false
+  name = "Alpha"
+  group = resource.hund_group.test.id
+
+  watchdog = { service = { manual = {} } }
+}
+
+resource "hund_component" "beta" {
+  deletion_protection = false
+  name = "Beta"
+  group = resource.hund_group.test.id
+
+  watchdog = { service = { manual = {} } }
+}
+
+resource "hund_component" "delta" {
+  deletion_protection = false
+  name = "Delta"
+  group = resource.hund_group.test.id
+
+  watchdog = { service = { manual = {} } }
+}
+
+resource "hund_group_component_ordering" "test" {
+  group = resource.hund_group.test.id
+  mode  = "prefix"
+
+  components = %[1]v
+
+  depends_on = [hund_component.alpha, hund_component.beta, hund_component.delta]
+}
+`, pinned, group)
+}
+
+func testAccGroupComponentOrderingResourceOrderByConfig(group string, orderBy string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "hund_group" "test" {
+  name = %[2]q
+}
+
+resource "hund_component" "delta" {
+  deletion_protection = false
+  name = "Delta"
+  group = resource.hund_group.test.id
+
+  watchdog = { service = { manual = {} } }
+}
+
+resource "hund_component" "beta" {
+  deletion_protection = false
+  name = "Beta"
+  group = resource.hund_group.test.id
+
+  watchdog = { service = { manual = {} } }
+}
+
+resource "hund_component" "alpha" {
+  deletion_protection = false
+  name = "Alpha"
+  group = resource.hund_group.test.id
+
+  watchdog = { service = { manual = {} } }
+}
+
+resource "hund_group_component_ordering" "test" {
+  group = resource.hund_group.test.id
+
+  order_by = %[1]v
+
+  depends_on = [hund_component.alpha, hund_component.beta, hund_component.delta]
+}
+`, orderBy, group)
 }
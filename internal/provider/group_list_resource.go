@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &GroupListResource{}
+var _ list.ListResourceWithConfigure = &GroupListResource{}
+
+func NewGroupListResource() list.ListResource {
+	return &GroupListResource{}
+}
+
+// GroupListResource defines the list resource implementation.
+type GroupListResource struct {
+	client *hundApiV1.Client
+}
+
+func (r *GroupListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Groups of the status page.",
+	}
+}
+
+func (r *GroupListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResourceClient(req, resp)
+}
+
+func (r *GroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	limit := listPageSize
+	params := hundApiV1.GetAllGroupsParams{
+		Limit: &limit,
+	}
+
+	retrievePage := func(startingAfter *string, diags *diag.Diagnostics) ([]hundApiV1.Group, bool) {
+		params.StartingAfter = startingAfter
+
+		rsp, err := r.client.GetAllGroups(ctx, &params)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund Groups",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		groups, err := hundApiV1.ParseGetAllGroupsResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund Groups",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		if groups.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(groups.StatusCode())+
+					"\nError: "+string(groups.Body),
+			)
+			return nil, false
+		}
+
+		return groups.HALJSON200.Data, groups.HALJSON200.HasMore
+	}
+
+	stream.Results = streamListResults(ctx, req, retrievePage, func(group hundApiV1.Group, result *list.ListResult) string {
+		result.DisplayName = i18nStringOriginal(group.Name)
+
+		model, diag := models.ToGroupModel(group)
+		result.Diagnostics.Append(diag...)
+
+		if result.Diagnostics.HasError() {
+			return group.Id
+		}
+
+		setListResult(ctx, req, result, group.Id, GroupResourceModel(model))
+
+		return group.Id
+	})
+}
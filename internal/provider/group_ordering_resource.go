@@ -0,0 +1,456 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupOrderingResource{}
+var _ resource.ResourceWithConfigure = &GroupOrderingResource{}
+var _ resource.ResourceWithImportState = &GroupOrderingResource{}
+var _ resource.ResourceWithIdentity = &GroupOrderingResource{}
+
+// groupOrderingId is the ID of the (singleton) hund_group_ordering of a status page.
+const groupOrderingId = "group_ordering"
+
+func NewGroupOrderingResource() resource.Resource {
+	return &GroupOrderingResource{}
+}
+
+// GroupOrderingResource defines the resource implementation.
+type GroupOrderingResource struct {
+	client *hundApiV1.Client
+
+	readOnly bool
+}
+
+// GroupOrderingResourceModel describes the resource data model.
+type GroupOrderingResourceModel models.GroupOrderingModel
+
+func (r *GroupOrderingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_ordering"
+}
+
+func (r *GroupOrderingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Resource representing the ordering of Groups on the status page.\n\n~> Only one `hund_group_ordering` should be declared per status page. The `position` of any Group ordered by this resource should not also be set on its `hund_group`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "How `groups` is applied to the status page. In `exact` mode, `groups` lists every Group on the status page. In `prefix` mode, only the listed Groups are placed, in order, at the top of the status page, and the remaining Groups keep their relative order beneath them. Defaults to `exact`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(orderingModeExact),
+				Validators: []validator.String{
+					stringvalidator.OneOf(orderingModeExact, orderingModePrefix),
+				},
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "The list of Group IDs, listed in the exact order they will appear on the status page. Group positions are updated only where necessary to achieve this order.\n\n~> In `exact` mode, this list **must not** omit nor add any Groups not already on the status page, or an error will occur.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GroupOrderingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the Group ordering, which is always `group_ordering`.")
+}
+
+func (r *GroupOrderingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*HundResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+func (r *GroupOrderingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group_ordering", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_group_ordering"))
+		return
+	}
+
+	var data GroupOrderingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.commitGroupOrdering(ctx, data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
+}
+
+func (r *GroupOrderingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group_ordering", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	var data GroupOrderingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups := retrieveGroups(ctx, r.client, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := models.ToGroupOrderingModel(groups)
+	newState.Id = types.StringValue(groupOrderingId)
+	newState.Mode = data.Mode
+
+	if newState.Mode.IsNull() {
+		newState.Mode = types.StringValue(orderingModeExact)
+	}
+
+	if newState.Mode.ValueString() == orderingModePrefix && len(data.Groups) < len(newState.Groups) {
+		newState.Groups = newState.Groups[:len(data.Groups)]
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
+}
+
+func (r *GroupOrderingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group_ordering", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_group_ordering"))
+		return
+	}
+
+	var data GroupOrderingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.commitGroupOrdering(ctx, data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
+}
+
+func (r *GroupOrderingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group_ordering", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_group_ordering"))
+		return
+	}
+
+	tflog.Debug(ctx, "deleting group_ordering")
+}
+
+func (r *GroupOrderingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), groupOrderingId)...)
+}
+
+func (r *GroupOrderingResource) commitGroupOrdering(ctx context.Context, data GroupOrderingResourceModel, diags *diag.Diagnostics) *models.GroupOrderingModel {
+	groups := retrieveGroups(ctx, r.client, diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	requested := stringValues(data.Groups)
+	current := models.ToGroupOrderingModel(groups)
+	currentIds := stringValues(current.Groups)
+
+	unknown := []string{}
+	for _, id := range requested {
+		if !slices.Contains(currentIds, id) {
+			unknown = append(unknown, id)
+		}
+	}
+
+	if len(unknown) > 0 {
+		diags.AddAttributeError(
+			path.Root("groups"),
+			"Group ID Does not Exist",
+			"A group_ordering can only order existing Groups. The following IDs were not found: "+strings.Join(unknown, ", "),
+		)
+		return nil
+	}
+
+	ordering := requested
+
+	if data.Mode.ValueString() == orderingModePrefix {
+		for _, id := range currentIds {
+			if !slices.Contains(requested, id) {
+				ordering = append(ordering, id)
+			}
+		}
+	} else if len(requested) != len(currentIds) {
+		missing := []string{}
+		for _, id := range currentIds {
+			if !slices.Contains(requested, id) {
+				missing = append(missing, id)
+			}
+		}
+
+		diags.AddAttributeError(
+			path.Root("groups"),
+			"Groups Missing from Ordering",
+			"A group_ordering in exact mode must list every Group on the status page. The following Groups were omitted: "+strings.Join(missing, ", "),
+		)
+		return nil
+	}
+
+	positions := map[string]int{}
+	for _, group := range groups {
+		positions[group.Id] = group.Position
+	}
+
+	updates := planGroupPositions(ordering, positions)
+
+	for _, id := range ordering {
+		position, ok := updates[id]
+		if !ok {
+			continue
+		}
+
+		tflog.Debug(ctx, "updating group position", map[string]interface{}{
+			"group":    id,
+			"position": position,
+		})
+
+		rsp, err := r.client.UpdateAGroup(ctx, id, hundApiV1.GroupFormUpdate{
+			Position: &position,
+		}, hundApiV1.Unexpand("components"))
+		if err != nil {
+			diags.AddError(
+				"Unable to Update Hund Group",
+				err.Error(),
+			)
+			return nil
+		}
+
+		group, err := hundApiV1.ParseUpdateAGroupResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund Group",
+				err.Error(),
+			)
+			return nil
+		}
+
+		if group.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(group.StatusCode())+
+					"\nError: "+string(group.Body),
+			)
+			return nil
+		}
+	}
+
+	groups = retrieveGroups(ctx, r.client, diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	newState := models.ToGroupOrderingModel(groups)
+	newState.Id = types.StringValue(groupOrderingId)
+	newState.Mode = data.Mode
+
+	if data.Mode.ValueString() == orderingModePrefix {
+		newState.Groups = newState.Groups[:len(requested)]
+	}
+
+	return &newState
+}
+
+func retrieveGroups(ctx context.Context, client *hundApiV1.Client, diags *diag.Diagnostics) []hundApiV1.Group {
+	result := []hundApiV1.Group{}
+
+	limit := 100
+	params := hundApiV1.GetAllGroupsParams{
+		Limit: &limit,
+	}
+
+	for {
+		rsp, err := client.GetAllGroups(ctx, &params, hundApiV1.Unexpand("data.components"))
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund Groups",
+				err.Error(),
+			)
+			return nil
+		}
+
+		groups, err := hundApiV1.ParseGetAllGroupsResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund Groups",
+				err.Error(),
+			)
+			return nil
+		}
+
+		if groups.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(groups.StatusCode())+
+					"\nError: "+string(groups.Body),
+			)
+			return nil
+		}
+
+		result = append(result, groups.HALJSON200.Data...)
+
+		if !groups.HALJSON200.HasMore || len(groups.HALJSON200.Data) == 0 {
+			return result
+		}
+
+		params.StartingAfter = &groups.HALJSON200.Data[len(groups.HALJSON200.Data)-1].Id
+	}
+}
+
+// planGroupPositions computes the new positions of the Groups which must move
+// in order for the given ordering to be displayed, keyed by Group ID. The
+// longest run of Groups already in order keeps its positions, so that as few
+// Groups as possible are updated.
+func planGroupPositions(ordering []string, positions map[string]int) map[string]int {
+	n := len(ordering)
+	updates := map[string]int{}
+
+	// Find the longest strictly increasing subsequence of current positions.
+	length := make([]int, n)
+	prev := make([]int, n)
+	best := -1
+
+	for i := range ordering {
+		length[i], prev[i] = 1, -1
+
+		for j := 0; j < i; j++ {
+			if positions[ordering[j]] < positions[ordering[i]] && length[j]+1 > length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+
+		if best == -1 || length[i] > length[best] {
+			best = i
+		}
+	}
+
+	fixed := make([]bool, n)
+	for i := best; i != -1; i = prev[i] {
+		fixed[i] = true
+	}
+
+	// Place each run of moved Groups between the fixed Groups around it.
+	low := -1
+	for i := 0; i < n; {
+		if fixed[i] {
+			low = positions[ordering[i]]
+			i++
+			continue
+		}
+
+		j := i
+		for j < n && !fixed[j] {
+			j++
+		}
+
+		if j < n && positions[ordering[j]]-low-1 < j-i {
+			// There is no room between the fixed Groups, so renumber them all.
+			clear(updates)
+
+			for k, id := range ordering {
+				if positions[id] != k {
+					updates[id] = k
+				}
+			}
+
+			return updates
+		}
+
+		for k := i; k < j; k++ {
+			low++
+			updates[ordering[k]] = low
+		}
+
+		i = j
+	}
+
+	return updates
+}
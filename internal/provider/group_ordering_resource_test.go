@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupOrderingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGroupOrderingResourceConfig(`[hund_group.gamma.id, hund_group.alpha.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_group_ordering.test", "mode", "prefix"),
+					resource.TestCheckResourceAttr("hund_group_ordering.test", "groups.#", "2"),
+					resource.TestCheckResourceAttrPair("hund_group_ordering.test", "groups.0", "hund_group.gamma", "id"),
+					resource.TestCheckResourceAttrPair("hund_group_ordering.test", "groups.1", "hund_group.alpha", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGroupOrderingResourceConfig(`[hund_group.beta.id, hund_group.gamma.id, hund_group.alpha.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_group_ordering.test", "groups.#", "3"),
+					resource.TestCheckResourceAttrPair("hund_group_ordering.test", "groups.0", "hund_group.beta", "id"),
+					resource.TestCheckResourceAttrPair("hund_group_ordering.test", "groups.1", "hund_group.gamma", "id"),
+					resource.TestCheckResourceAttrPair("hund_group_ordering.test", "groups.2", "hund_group.alpha", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// TestAccGroupOrderingResource_exact drags Groups around in exact mode. The
+// plan following each step checks that the Hund API keeps positions exactly as
+// written, without shifting other Groups when a Group is moved or created.
+// As exact mode lists every Group, the status page must have no other Groups.
+func TestAccGroupOrderingResource_exact(t *testing.T) {
+	steps := [][]string{
+		{"alpha", "beta", "gamma"},
+		// Move the last Group to the front.
+		{"gamma", "alpha", "beta"},
+		// Swap two Groups.
+		{"alpha", "gamma", "beta"},
+		// Move the first Group to the back.
+		{"gamma", "beta", "alpha"},
+		// Insert a new Group in the middle.
+		{"gamma", "delta", "beta", "alpha"},
+	}
+
+	testSteps := []resource.TestStep{}
+
+	for _, ordering := range steps {
+		checks := []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr("hund_group_ordering.test", "mode", "exact"),
+			resource.TestCheckResourceAttr("hund_group_ordering.test", "groups.#", fmt.Sprint(len(ordering))),
+		}
+
+		for i, name := range ordering {
+			checks = append(checks, resource.TestCheckResourceAttrPair("hund_group_ordering.test", fmt.Sprintf("groups.%d", i), "hund_group."+name, "id"))
+		}
+
+		testSteps = append(testSteps, resource.TestStep{
+			Config: testAccGroupOrderingResourceExactConfig(t.Name(), ordering),
+			Check:  resource.ComposeAggregateTestCheckFunc(checks...),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    testSteps,
+	})
+}
+
+func TestPlanGroupPositions(t *testing.T) {
+	cases := []struct {
+		ordering  []string
+		positions map[string]int
+		updates   int
+	}{
+		{[]string{"a", "b", "c"}, map[string]int{"a": 0, "b": 1, "c": 2}, 0},
+		{[]string{"c", "a", "b"}, map[string]int{"a": 1, "b": 2, "c": 3}, 1},
+		{[]string{"b", "a", "c"}, map[string]int{"a": 0, "b": 1, "c": 2}, 2},
+		{[]string{"a", "c", "b", "d"}, map[string]int{"a": 0, "b": 10, "c": 20, "d": 30}, 1},
+		{[]string{"d", "c", "b", "a"}, map[string]int{"a": 0, "b": 1, "c": 2, "d": 3}, 4},
+	}
+
+	for _, c := range cases {
+		updates := planGroupPositions(c.ordering, c.positions)
+
+		if len(updates) > c.updates {
+			t.Errorf("%v: expected at most %d updates, got %v", c.ordering, c.updates, updates)
+		}
+
+		final := map[string]int{}
+		for id, position := range c.positions {
+			final[id] = position
+		}
+		for id, position := range updates {
+			final[id] = position
+		}
+
+		result := slices.Clone(c.ordering)
+		sort.SliceStable(result, func(i, j int) bool { return final[result[i]] < final[result[j]] })
+
+		if !slices.Equal(result, c.ordering) || len(final) != len(c.positions) {
+			t.Errorf("%v: positions %v do not produce the expected ordering", c.ordering, final)
+		}
+
+		seen := map[int]bool{}
+		for _, position := range final {
+			if seen[position] {
+				t.Errorf("%v: positions %v are not distinct", c.ordering, final)
+			}
+			seen[position] = true
+		}
+	}
+}
+
+func testAccGroupOrderingResourceConfig(groups string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "hund_group" "alpha" {
+  name = "Alpha"
+}
+
+resource "hund_group" "beta" {
+  name = "Beta"
+}
+
+resource "hund_group" "gamma" {
+  name = "Gamma"
+}
+
+resource "hund_group_ordering" "test" {
+  mode = "prefix"
+
+  groups = %[1]v
+}
+`, groups)
+}
+
+func testAccGroupOrderingResourceExactConfig(prefix string, ordering []string) string {
+	config := providerConfig
+	groups := []string{}
+
+	for _, name := range ordering {
+		config += fmt.Sprintf(`
+resource "hund_group" %[1]q {
+  name = "%[2]s %[1]s"
+}
+`, name, prefix)
+
+		groups = append(groups, "hund_group."+name+".id")
+	}
+
+	return config + fmt.Sprintf(`
+resource "hund_group_ordering" "test" {
+  mode = "exact"
+
+  groups = [%[1]s]
+}
+`, strings.Join(groups, ", "))
+}
@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,12 +26,14 @@ import (
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
 	"github.com/hundio/terraform-provider-hund/internal/models"
 	"github.com/hundio/terraform-provider-hund/internal/planmodifiers"
+	"github.com/hundio/terraform-provider-hund/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithConfigure = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithIdentity = &GroupResource{}
 var _ resource.ResourceWithConfigValidators = &GroupResource{}
 var _ resource.ResourceWithModifyPlan = &GroupResource{}
 
@@ -36,7 +43,13 @@ func NewGroupResource() resource.Resource {
 
 // GroupResource defines the resource implementation.
 type GroupResource struct {
-	client *hundApiV1.Client
+	client     *hundApiV1.Client
+	groupMutex *MutexKV
+	locales    []string
+
+	renderMarkdownLocally bool
+	conflictDetection     bool
+	readOnly              bool
 }
 
 // GroupResourceModel describes the resource data model.
@@ -86,6 +99,9 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -103,6 +119,9 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -148,6 +167,37 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"on_destroy": schema.SingleNestedAttribute{
+				MarkdownDescription: "The policy applied to the Components remaining in this Group when it is destroyed. Exactly one of the following must be given. When not set, the Hund API decides what happens to any remaining Components.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"fail_if_not_empty": schema.BoolAttribute{
+						MarkdownDescription: "When true, refuse to destroy this Group while it still contains Components. May only be set to true.",
+						Optional:            true,
+						Validators: []validator.Bool{
+							validators.BoolTrue(),
+						},
+					},
+					"move_components_to": schema.StringAttribute{
+						MarkdownDescription: "The ID of a Group to which any remaining Components are moved before this Group is destroyed.",
+						Optional:            true,
+					},
+					"delete_components": schema.BoolAttribute{
+						MarkdownDescription: "When true, explicitly delete any remaining Components before this Group is destroyed. May only be set to true. **Note:** the `deletion_protection` of any `hund_component` managing these Components is not consulted, since it is only known to that resource; Components which are also destroyed by the same plan are destroyed by their own resources first.",
+						Optional:            true,
+						Validators: []validator.Bool{
+							validators.BoolTrue(),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRelative().AtName("fail_if_not_empty"),
+						path.MatchRelative().AtName("move_components_to"),
+						path.MatchRelative().AtName("delete_components"),
+					),
+				},
+			},
 		},
 	}
 }
@@ -166,6 +216,33 @@ func (r *GroupResource) ConfigValidators(ctx context.Context) []resource.ConfigV
 }
 
 func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkTranslationLocales(ctx, r.locales, req.Config, &resp.Diagnostics)
+
+	if r.renderMarkdownLocally {
+		// Predict the rendering once the plan is otherwise complete.
+		defer predictMarkdownHtml(ctx, &resp.Plan, "description", &resp.Diagnostics)
+	}
+
+	if req.Plan.Raw.IsNull() && r.client != nil {
+		var data GroupResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The warning is best-effort, so errors retrieving the Components are ignored.
+		var diags diag.Diagnostics
+		components := retrieveGroupComponents(ctx, r.client, data.Id.ValueString(), &diags)
+
+		if !diags.HasError() && len(components) > 0 {
+			resp.Diagnostics.Append(GroupDestructionWarning(data.OnDestroy, componentNames(components)))
+		}
+
+		return
+	}
+
 	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
 		return
 	}
@@ -200,27 +277,44 @@ func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 	}
 }
 
+func (r *GroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ObjectId of this Group.")
+}
+
 func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*hundApiV1.Client)
+	data, ok := req.ProviderData.(*HundResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hundApiV1.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.locales = data.Locales
+	r.renderMarkdownLocally = data.RenderMarkdownLocally
+	r.conflictDetection = data.ConflictDetection
+	r.groupMutex = data.GroupMutex
 }
 
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_group"))
+		return
+	}
+
 	var data GroupResourceModel
 
 	// Read Terraform plan data into the model
@@ -279,19 +373,29 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	newState, diag := models.ToGroupModel(*group.HALJSON201)
 	resp.Diagnostics.Append(diag...)
 
+	newState.OnDestroy = data.OnDestroy
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if r.renderMarkdownLocally {
+		reconcileMarkdownHtml("description", data.DescriptionHtml, &newState.DescriptionHtml, data.DescriptionHtmlTranslations, &newState.DescriptionHtmlTranslations, &resp.Diagnostics)
+	}
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	var data GroupResourceModel
 
 	// Read Terraform prior state data into the model
@@ -336,24 +440,44 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	newState, diag := models.ToGroupModel(*group.HALJSON200)
 	resp.Diagnostics.Append(diag...)
 
+	newState.OnDestroy = data.OnDestroy
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data GroupResourceModel
+	ctx, span := startResourceSpan(ctx, "hund_group", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_group"))
+		return
+	}
+
+	var data, state GroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if r.conflictDetection {
+		r.detectConflict(ctx, state, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	name, err := hundApiV1.ToI18nString(data.Name, data.NameTranslations)
 	if err != nil {
 		resp.Diagnostics.Append(models.I18nStringError(err))
@@ -403,15 +527,30 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	newState, diag := models.ToGroupModel(*group.HALJSON200)
 	resp.Diagnostics.Append(diag...)
 
+	newState.OnDestroy = data.OnDestroy
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if r.renderMarkdownLocally {
+		reconcileMarkdownHtml("description", data.DescriptionHtml, &newState.DescriptionHtml, data.DescriptionHtmlTranslations, &newState.DescriptionHtmlTranslations, &resp.Diagnostics)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_group", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_group"))
+		return
+	}
+
 	var data GroupResourceModel
 
 	// Read Terraform prior state data into the model
@@ -421,14 +560,15 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// if len(data.Components.Elements()) > 0 {
-	// 	resp.Diagnostics.AddError(
-	// 		"Cannot delete a non-empty Group",
-	// 		"This Group cannot be deleted unless its Components are moved to another"+
-	// 			" Group, or otherwise deleted.",
-	// 	)
-	// 	return
-	// }
+	if data.OnDestroy != nil {
+		unlock := r.groupMutex.LockAll(data.Id.ValueString(), data.OnDestroy.MoveComponentsTo.ValueString())
+		r.applyOnDestroy(ctx, data, &resp.Diagnostics)
+		unlock()
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	rsp, err := r.client.DeleteAGroup(ctx, data.Id.ValueString())
 	if err != nil {
@@ -456,6 +596,148 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// detectConflict reports an error when the Group was modified outside
+// Terraform since the prior state was read.
+func (r *GroupResource) detectConflict(ctx context.Context, state GroupResourceModel, diags *diag.Diagnostics) {
+	rsp, err := r.client.RetrieveAGroup(ctx, state.Id.ValueString(), hundApiV1.Unexpand("components"))
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Hund Group",
+			err.Error(),
+		)
+		return
+	}
+
+	group, err := hundApiV1.ParseRetrieveAGroupResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Group",
+			err.Error(),
+		)
+		return
+	}
+
+	if group.StatusCode() != 200 {
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-200 status code: "+fmt.Sprint(group.StatusCode())+
+				"\nError: "+string(group.Body),
+		)
+		return
+	}
+
+	remote, diag := models.ToGroupModel(*group.HALJSON200)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(detectConflict("Group", state, GroupResourceModel(remote), "on_destroy")...)
+}
+
+// applyOnDestroy prepares the Components remaining in the Group for its
+// destruction, according to its on_destroy policy.
+func (r *GroupResource) applyOnDestroy(ctx context.Context, data GroupResourceModel, diags *diag.Diagnostics) {
+	components := retrieveGroupComponents(ctx, r.client, data.Id.ValueString(), diags)
+
+	if diags.HasError() || len(components) == 0 {
+		return
+	}
+
+	if data.OnDestroy.FailIfNotEmpty.ValueBool() {
+		diags.AddError(
+			"Cannot delete a non-empty Group",
+			"This Group cannot be deleted unless its Components are moved to another"+
+				" Group, or otherwise deleted. The Group still contains: "+strings.Join(componentNames(components), ", "),
+		)
+		return
+	}
+
+	for id := range components {
+		if !data.OnDestroy.MoveComponentsTo.IsNull() {
+			tflog.Debug(ctx, "moving component out of destroyed group", map[string]interface{}{
+				"component": id,
+				"group":     data.OnDestroy.MoveComponentsTo.ValueString(),
+			})
+
+			rsp, err := r.client.UpdateAComponent(ctx, id, hundApiV1.ComponentFormUpdate{
+				Group: data.OnDestroy.MoveComponentsTo.ValueStringPointer(),
+			})
+			if err != nil {
+				diags.AddError(
+					"Unable to Update Hund Component",
+					err.Error(),
+				)
+				return
+			}
+
+			if rsp.StatusCode != 200 && rsp.StatusCode != 404 {
+				summary := "Received a non-200 status code: " + fmt.Sprint(rsp.StatusCode)
+
+				component, err := hundApiV1.ParseUpdateAComponentResponse(rsp)
+
+				if err == nil {
+					summary = summary + "\nError: " + string(component.Body)
+				}
+
+				diags.AddError(
+					"Failed response code from Hund API",
+					summary,
+				)
+				return
+			}
+		} else if data.OnDestroy.DeleteComponents.ValueBool() {
+			tflog.Debug(ctx, "deleting component of destroyed group", map[string]interface{}{
+				"component": id,
+			})
+
+			rsp, err := r.client.DeleteAComponent(ctx, id)
+			if err != nil {
+				diags.AddError(
+					"Unable to Delete Hund Component",
+					err.Error(),
+				)
+				return
+			}
+
+			if rsp.StatusCode != 204 && rsp.StatusCode != 404 {
+				summary := "Received a non-200 status code: " + fmt.Sprint(rsp.StatusCode)
+
+				component, err := hundApiV1.ParseDeleteAComponentResponse(rsp)
+
+				if err == nil {
+					summary = summary + "\nError: " + string(component.Body)
+				}
+
+				diags.AddError(
+					"Failed response code from Hund API",
+					summary,
+				)
+				return
+			}
+		}
+	}
+}
+
+func componentNames(components map[string]hundApiV1.ComponentExpansionary) []string {
+	names := []string{}
+
+	for id, component := range components {
+		name := id
+
+		if i18n, err := component.Name.AsI18nString1(); err == nil {
+			name = fmt.Sprintf("%s (%s)", i18n[i18n["original"]], id)
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -2,12 +2,23 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
 )
 
 func init() {
@@ -89,6 +100,208 @@ func TestAccGroupResource(t *testing.T) {
 	})
 }
 
+func TestAccGroupResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupResourceConfig("one"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("hund_group.test", tfjsonpath.New("id")),
+				},
+			},
+			// Import by identity testing
+			{
+				ResourceName:    "hund_group.test",
+				Config:          testAccGroupResourceConfig("one"),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccGroupResource_onDestroyMoveComponents(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// removed blocks are required to leave a Component behind in the Group.
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupResourceOnDestroyConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hund_group.old", "on_destroy.move_components_to", "hund_group.new", "id"),
+				),
+			},
+			{
+				Config: testAccGroupResourceOnDestroyConfig(false),
+				Check: func(s *terraform.State) error {
+					client, err := sharedClientForDomain("")
+					if err != nil {
+						return err
+					}
+
+					group := s.RootModule().Resources["hund_group.new"].Primary.ID
+					rsp, err := client.GetAllComponents(context.Background(), &hundApiV1.GetAllComponentsParams{
+						Group: &group,
+					})
+					if err != nil {
+						return err
+					}
+
+					components, err := hundApiV1.ParseGetAllComponentsResponse(rsp)
+					if err != nil {
+						return err
+					}
+					if components.StatusCode() != 200 {
+						return errors.New("couldn't retrieve components:\n" + string(components.Body))
+					}
+
+					if len(components.HALJSON200.Data) != 1 {
+						return fmt.Errorf("expected 1 component to be moved to group %s, got %d", group, len(components.HALJSON200.Data))
+					}
+
+					return nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGroupResource_onDestroyFalse(t *testing.T) {
+	for _, attribute := range []string{"fail_if_not_empty", "delete_components"} {
+		t.Run(attribute, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "hund_group" "test" {
+  name = "on_destroy false"
+
+  on_destroy = {
+    %s = false
+  }
+}
+`, attribute),
+						ExpectError: regexp.MustCompile(`may only be set to\s+true`),
+						PlanOnly:    true,
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccGroupResource_renderMarkdownLocally(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupResourceConfigRenderMarkdownLocally("**one**"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("hund_group.test", tfjsonpath.New("description_html"), knownvalue.StringExact("<p><strong>one</strong></p>\n")),
+					},
+				},
+			},
+			{
+				Config: testAccGroupResourceConfigRenderMarkdownLocally("*two*"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("hund_group.test", tfjsonpath.New("description_html"), knownvalue.StringExact("<p><em>two</em></p>\n")),
+					},
+				},
+			},
+		},
+	})
+}
+
+// TestGroupFixture_translations decodes the I18nStrings of a Group, as
+// recorded from the Hund API, which returns every translation of each field
+// along with its `original` locale.
+func TestGroupFixture_translations(t *testing.T) {
+	ctx := context.Background()
+	client := testFixtureClient(t)
+
+	group := testFixtureCreateGroup(t, client, `{
+		"name": "Fixture Translations",
+		"description": {"original": "en", "en": "**bold**", "de": "*kursiv*"}
+	}`)
+
+	rsp, err := client.RetrieveAGroup(ctx, group.Id, hundApiV1.Unexpand("components"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	retrieved, err := hundApiV1.ParseRetrieveAGroupResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if retrieved.StatusCode() != 200 {
+		t.Fatalf("expected to retrieve the group, got %d: %s", retrieved.StatusCode(), retrieved.Body)
+	}
+
+	model, diags := models.ToGroupModel(*retrieved.HALJSON200)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if model.Name.ValueString() != "Fixture Translations" || model.NameTranslations.Elements()["original"] != types.StringValue("en") {
+		t.Errorf("expected a single translation of name, got %s and %s", model.Name, model.NameTranslations)
+	}
+
+	if model.Description.ValueString() != "**bold**" || model.DescriptionTranslations.Elements()["de"] != types.StringValue("*kursiv*") {
+		t.Errorf("expected every translation of description, got %s and %s", model.Description, model.DescriptionTranslations)
+	}
+
+	if html, _ := model.DescriptionHtmlTranslations.Elements()["de"].(types.String); !strings.Contains(html.ValueString(), "<em>kursiv</em>") {
+		t.Errorf("expected every rendering of description, got %s", model.DescriptionHtmlTranslations)
+	}
+
+	if _, err := client.DeleteAGroup(ctx, group.Id); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testFixtureCreateGroup creates a Group from the given JSON form, failing
+// the test if it cannot be created.
+func testFixtureCreateGroup(t *testing.T, client *hundApiV1.Client, form string) hundApiV1.Group {
+	t.Helper()
+
+	var body hundApiV1.GroupFormCreate
+	if err := json.Unmarshal([]byte(form), &body); err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := client.CreateAGroup(context.Background(), body, hundApiV1.Unexpand("components"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := hundApiV1.ParseCreateAGroupResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group.StatusCode() != 201 {
+		t.Fatalf("expected to create a group, got %d: %s", group.StatusCode(), group.Body)
+	}
+
+	return *group.HALJSON201
+}
+
 func testAccGroupResourceConfig(name string) string {
 	return providerConfig + fmt.Sprintf(`
 resource "hund_group" "test" {
@@ -108,3 +321,55 @@ resource "hund_group" "test" {
 }
 `, name_en, name_de)
 }
+
+func testAccGroupResourceConfigRenderMarkdownLocally(description string) string {
+	return fmt.Sprintf(`
+provider "hund" {
+  render_markdown_locally = true
+}
+
+resource "hund_group" "test" {
+  name        = "Test Group"
+  description = %[1]q
+}
+`, description)
+}
+
+func testAccGroupResourceOnDestroyConfig(withOld bool) string {
+	config := providerConfig + `
+resource "hund_group" "new" {
+  name = "New Group"
+}
+`
+
+	if withOld {
+		return config + `
+resource "hund_group" "old" {
+  name = "Old Group"
+
+  on_destroy = {
+    move_components_to = hund_group.new.id
+  }
+}
+
+resource "hund_component" "test" {
+  deletion_protection = false
+  name = "Moved"
+
+  group = hund_group.old.id
+
+  watchdog = { service = { manual = {} } }
+}
+`
+	}
+
+	return config + `
+removed {
+  from = hund_component.test
+
+  lifecycle {
+    destroy = false
+  }
+}
+`
+}
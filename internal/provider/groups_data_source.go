@@ -87,6 +87,20 @@ func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 							Computed:    true,
 							ElementType: types.StringType,
 						},
+						"on_destroy": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"fail_if_not_empty": schema.BoolAttribute{
+									Computed: true,
+								},
+								"move_components_to": schema.StringAttribute{
+									Computed: true,
+								},
+								"delete_components": schema.BoolAttribute{
+									Computed: true,
+								},
+							},
+						},
 					},
 				},
 			},
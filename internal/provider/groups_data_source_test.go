@@ -39,6 +39,7 @@ func testAccGroupsDataSourceConfig_base() string {
 		}
 
 		resource "hund_component" "test0" {
+			deletion_protection = false
 			group = hund_group.test0.id
 			name = "Test Component"
 
@@ -46,6 +47,7 @@ func testAccGroupsDataSourceConfig_base() string {
 		}
 
 		resource "hund_component" "test1" {
+			deletion_protection = false
 			group = hund_group.test1.id
 			name = "Test Component"
 
@@ -6,9 +6,40 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
 )
 
 func TestMain(m *testing.M) {
+	// HUND_FIXTURES=record records the interactions of each acceptance test
+	// with the Hund API into testdata/fixtures, and HUND_FIXTURES=replay
+	// replays them, without network access.
+	switch os.Getenv("HUND_FIXTURES") {
+	case "record":
+		testRecorder = hundtest.NewRecorder(hundtest.ModeRecord)
+	case "replay":
+		testRecorder = hundtest.NewRecorder(hundtest.ModeReplay)
+
+		os.Setenv("HUND_DOMAIN", "")
+		os.Setenv("HUND_ENDPOINT", testFixtureEndpoint)
+		os.Setenv("HUND_KEY", "replay")
+	}
+
+	if testRecorder != nil {
+		transport = testRecorder
+	}
+
+	// Without a status page to test against, acceptance tests are run
+	// hermetically against an in-process fake of the Hund API.
+	if os.Getenv("TF_ACC") != "" && os.Getenv("HUND_DOMAIN") == "" && os.Getenv("HUND_ENDPOINT") == "" {
+		testFakeServer = hundtest.NewServer()
+
+		os.Setenv("HUND_ENDPOINT", testFakeServer.Endpoint())
+
+		if os.Getenv("HUND_KEY") == "" {
+			os.Setenv("HUND_KEY", "hundtest")
+		}
+	}
+
 	resource.TestMain(m)
 }
 
@@ -20,7 +51,13 @@ func sharedClientForDomain(domain string) (*hundApiV1.Client, error) {
 		domain = domainEnv
 	}
 
+	endpoint := os.Getenv("HUND_ENDPOINT")
+
+	if endpoint == "" {
+		endpoint = domainEndpoint(domain)
+	}
+
 	key := os.Getenv("HUND_KEY")
 
-	return newProviderClient("test", domain, key)
+	return newProviderClient("test", endpoint, key)
 }
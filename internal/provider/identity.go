@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdIdentityModel describes the identity of resources which are identified
+// by their ID alone.
+type IdIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// IssueUpdateIdentityModel describes the identity of an Issue Update, which
+// is only addressable through its Issue.
+type IssueUpdateIdentityModel struct {
+	Id      types.String `tfsdk:"id"`
+	IssueId types.String `tfsdk:"issue_id"`
+}
+
+func idIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setIdIdentity records the given ID as the resource identity, if the
+// Terraform client supports resource identity.
+func setIdIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, IdIdentityModel{Id: id})
+}
+
+// setIssueUpdateIdentity records the given IDs as the identity of an Issue
+// Update, if the Terraform client supports resource identity.
+func setIssueUpdateIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String, issueId types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, IssueUpdateIdentityModel{Id: id, IssueId: issueId})
+}
+
+// importIdentityId returns the ID given to an import, either as the import ID,
+// or as the id attribute of the import identity.
+func importIdentityId(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	var id types.String
+	diags.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+
+	return id.ValueString()
+}
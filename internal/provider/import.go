@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+var objectIdRegexp = regexp.MustCompile(`^[0-9a-fA-F]{24}$`)
+
+// isObjectId reports whether the given import ID looks like a Hund ObjectId,
+// rather than a name to be looked up.
+func isObjectId(id string) bool {
+	return objectIdRegexp.MatchString(id)
+}
+
+// importIdExists reports whether the object with an import ID that looks like
+// an ObjectId exists, given a function retrieving it. When it does not, the ID
+// may instead be the name of an object, which happens to look like an
+// ObjectId.
+func importIdExists(kind string, retrieve func() (*http.Response, error), diags *diag.Diagnostics) bool {
+	rsp, err := retrieve()
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Hund "+kind,
+			err.Error(),
+		)
+		return false
+	}
+
+	defer rsp.Body.Close()
+
+	switch rsp.StatusCode {
+	case 200:
+		return true
+	case 404:
+		return false
+	}
+
+	body, _ := io.ReadAll(rsp.Body)
+
+	diags.AddError(
+		"Failed response code from Hund API",
+		"Received a non-200 status code: "+fmt.Sprint(rsp.StatusCode)+
+			"\nError: "+string(body),
+	)
+
+	return false
+}
+
+// splitComponentImportId splits a Component import ID into its Group and
+// Component name at the last forward slash which is not escaped by a
+// backslash, so that Group names may contain forward slashes, and Component
+// names may contain them when escaped (`\/`).
+func splitComponentImportId(id string) (string, string, bool) {
+	for i := len(id) - 1; i >= 0; i-- {
+		if id[i] == '/' && (i == 0 || id[i-1] != '\\') {
+			return id[:i], strings.ReplaceAll(id[i+1:], `\/`, "/"), true
+		}
+	}
+
+	return "", "", false
+}
+
+// resolveGroupImportId returns the ID of the Group identified by the given
+// ObjectId or name.
+func resolveGroupImportId(ctx context.Context, client *hundApiV1.Client, id string, diags *diag.Diagnostics) string {
+	if isObjectId(id) {
+		exists := importIdExists("Group", func() (*http.Response, error) {
+			return client.RetrieveAGroup(ctx, id, hundApiV1.Unexpand("components"))
+		}, diags)
+
+		if diags.HasError() {
+			return ""
+		} else if exists {
+			return id
+		}
+	}
+
+	groups := retrieveGroups(ctx, client, diags)
+	if diags.HasError() {
+		return ""
+	}
+
+	matches := []string{}
+
+	for _, group := range groups {
+		if i18nStringOriginal(group.Name) == id {
+			matches = append(matches, group.Id)
+		}
+	}
+
+	return uniqueImportMatch("Group", id, matches, diags)
+}
+
+// resolveComponentImportId returns the ID of the Component identified by the
+// given ObjectId, or by a Group (ObjectId or name) and a Component name,
+// separated by a forward slash.
+func resolveComponentImportId(ctx context.Context, client *hundApiV1.Client, id string, diags *diag.Diagnostics) string {
+	if isObjectId(id) {
+		exists := importIdExists("Component", func() (*http.Response, error) {
+			return client.RetrieveAComponent(ctx, id)
+		}, diags)
+
+		if diags.HasError() {
+			return ""
+		} else if exists {
+			return id
+		}
+	}
+
+	groupId, name, ok := splitComponentImportId(id)
+	if !ok {
+		diags.AddError(
+			"Could not parse Hund Component Import ID",
+			"To import a Component, please provide either the Component ID, or the"+
+				" Group name (or ID), a forward slash, and finally the Component name:"+
+				" <GROUP_NAME>/<COMPONENT_NAME>. Forward slashes in the Component name"+
+				" must be escaped with a backslash (\\/).",
+		)
+		return ""
+	}
+
+	groupId = resolveGroupImportId(ctx, client, groupId, diags)
+	if diags.HasError() {
+		return ""
+	}
+
+	components := retrieveGroupComponents(ctx, client, groupId, diags)
+	if diags.HasError() {
+		return ""
+	}
+
+	matches := []string{}
+
+	for _, component := range components {
+		if i18nStringOriginal(component.Name) == name {
+			matches = append(matches, component.Id)
+		}
+	}
+
+	return uniqueImportMatch("Component", id, matches, diags)
+}
+
+// resolveIssueTemplateImportId returns the ID of the IssueTemplate identified
+// by the given ObjectId or name.
+func resolveIssueTemplateImportId(ctx context.Context, client *hundApiV1.Client, id string, diags *diag.Diagnostics) string {
+	if isObjectId(id) {
+		exists := importIdExists("IssueTemplate", func() (*http.Response, error) {
+			return client.RetrieveAIssueTemplate(ctx, id)
+		}, diags)
+
+		if diags.HasError() {
+			return ""
+		} else if exists {
+			return id
+		}
+	}
+
+	templates := retrieveIssueTemplates(ctx, client, diags)
+	if diags.HasError() {
+		return ""
+	}
+
+	matches := []string{}
+
+	for _, template := range templates {
+		if template.Name == id {
+			matches = append(matches, template.Id)
+		}
+	}
+
+	return uniqueImportMatch("IssueTemplate", id, matches, diags)
+}
+
+func retrieveIssueTemplates(ctx context.Context, client *hundApiV1.Client, diags *diag.Diagnostics) []hundApiV1.IssueTemplate {
+	result := []hundApiV1.IssueTemplate{}
+
+	limit := 100
+	params := hundApiV1.GetAllIssueTemplatesParams{
+		Limit: &limit,
+	}
+
+	for {
+		rsp, err := client.GetAllIssueTemplates(ctx, &params)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund IssueTemplates",
+				err.Error(),
+			)
+			return nil
+		}
+
+		templates, err := hundApiV1.ParseGetAllIssueTemplatesResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund IssueTemplates",
+				err.Error(),
+			)
+			return nil
+		}
+
+		if templates.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(templates.StatusCode())+
+					"\nError: "+string(templates.Body),
+			)
+			return nil
+		}
+
+		result = append(result, templates.HALJSON200.Data...)
+
+		if !templates.HALJSON200.HasMore || len(templates.HALJSON200.Data) == 0 {
+			return result
+		}
+
+		params.StartingAfter = &templates.HALJSON200.Data[len(templates.HALJSON200.Data)-1].Id
+	}
+}
+
+// uniqueImportMatch returns the only ID in matches, or reports an error if
+// the name matched no objects, or more than one.
+func uniqueImportMatch(kind string, name string, matches []string, diags *diag.Diagnostics) string {
+	switch len(matches) {
+	case 1:
+		return matches[0]
+	case 0:
+		detail := "Could not find a " + kind + " named \"" + name + "\" to import."
+
+		if isObjectId(name) {
+			detail = "Could not find a " + kind + " with the ID or name \"" + name + "\" to import."
+		}
+
+		diags.AddError(
+			"Hund "+kind+" Not Found",
+			detail,
+		)
+	default:
+		diags.AddError(
+			"Ambiguous Hund "+kind+" Import ID",
+			"More than one "+kind+" is named \""+name+"\". Please import by ID"+
+				" instead, using one of the following:\n\n  - "+strings.Join(matches, "\n  - "),
+		)
+	}
+
+	return ""
+}
+
+// i18nStringOriginal returns the value of the given I18nString in its
+// original locale.
+func i18nStringOriginal(i18nString hundApiV1.I18nString) string {
+	value, _, diag0 := hundApiV1.FromI18nString(i18nString)
+	if diag0.HasError() {
+		return ""
+	}
+
+	return value.ValueString()
+}
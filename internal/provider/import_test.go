@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
+)
+
+func TestSplitComponentImportId(t *testing.T) {
+	cases := []struct {
+		id, group, name string
+		ok              bool
+	}{
+		{"Group/Component", "Group", "Component", true},
+		{"Ops/Infra/Component", "Ops/Infra", "Component", true},
+		{`Group/TCP\/UDP`, "Group", "TCP/UDP", true},
+		{`Ops/Infra/TCP\/UDP`, "Ops/Infra", "TCP/UDP", true},
+		{"Component", "", "", false},
+		{`TCP\/UDP`, "", "", false},
+	}
+
+	for _, c := range cases {
+		group, name, ok := splitComponentImportId(c.id)
+
+		if group != c.group || name != c.name || ok != c.ok {
+			t.Errorf("%q: expected (%q, %q, %t), got (%q, %q, %t)", c.id, c.group, c.name, c.ok, group, name, ok)
+		}
+	}
+}
+
+func TestResolveComponentImportId(t *testing.T) {
+	ctx := context.Background()
+
+	server := hundtest.NewServer()
+	defer server.Close()
+
+	client, err := newProviderClient("test", server.Endpoint(), "hundtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A name which looks like an ObjectId, but is not the ID of any object.
+	hexName := "0123456789abcdef01234567"
+
+	slashed := testImportCreateGroup(t, client, "Ops/Infra")
+	hex := testImportCreateGroup(t, client, hexName)
+
+	tcp := testImportCreateComponent(t, client, slashed, "TCP/UDP")
+	hexComponent := testImportCreateComponent(t, client, hex, hexName)
+
+	cases := []struct {
+		id       string
+		expected string
+	}{
+		{tcp, tcp},
+		{`Ops/Infra/TCP\/UDP`, tcp},
+		{slashed + `/TCP\/UDP`, tcp},
+		{hexName + "/" + hexName, hexComponent},
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+
+		if actual := resolveComponentImportId(ctx, client, c.id, &diags); actual != c.expected || diags.HasError() {
+			t.Errorf("%q: expected %s, got %q and %v", c.id, c.expected, actual, diags)
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	if resolveGroupImportId(ctx, client, hexName, &diags); diags.HasError() {
+		t.Errorf("expected a Group named like an ObjectId to be found by name, got %v", diags)
+	}
+
+	diags = diag.Diagnostics{}
+	resolveGroupImportId(ctx, client, "fedcba9876543210fedcba98", &diags)
+
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "with the ID or name") {
+		t.Errorf("expected an unknown ObjectId to be reported as not found, got %v", diags)
+	}
+}
+
+func testImportCreateGroup(t *testing.T, client *hundApiV1.Client, name string) string {
+	t.Helper()
+
+	i18nName := hundApiV1.I18nString{}
+	if err := i18nName.FromI18nString0(name); err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := client.CreateAGroup(context.Background(), hundApiV1.GroupFormCreate{Name: i18nName}, hundApiV1.Unexpand("components"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := hundApiV1.ParseCreateAGroupResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group.StatusCode() != 201 {
+		t.Fatalf("expected to create a group, got %d: %s", group.StatusCode(), group.Body)
+	}
+
+	return group.HALJSON201.Id
+}
+
+func testImportCreateComponent(t *testing.T, client *hundApiV1.Client, group string, name string) string {
+	t.Helper()
+
+	i18nName := hundApiV1.I18nString{}
+	if err := i18nName.FromI18nString0(name); err != nil {
+		t.Fatal(err)
+	}
+
+	service := hundApiV1.FormWatchdogCreate{}
+	if err := service.FromFormWatchdogCreate0(hundApiV1.ManualFormCreate{State: 1, Type: hundApiV1.ManualFormCreateTypeManual}); err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := client.CreateAComponent(context.Background(), hundApiV1.ComponentFormCreate{
+		Group:    group,
+		Name:     i18nName,
+		Watchdog: hundApiV1.WatchdogFormCreate{Service: service},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	component, err := hundApiV1.ParseCreateAComponentResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if component.StatusCode() != 201 {
+		t.Fatalf("expected to create a component, got %d: %s", component.StatusCode(), component.Body)
+	}
+
+	return component.HALJSON201.Id
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &IssueListResource{}
+var _ list.ListResourceWithConfigure = &IssueListResource{}
+
+func NewIssueListResource() list.ListResource {
+	return &IssueListResource{}
+}
+
+// IssueListResource defines the list resource implementation.
+type IssueListResource struct {
+	client *hundApiV1.Client
+}
+
+// IssueListResourceModel describes the list resource data model.
+type IssueListResourceModel struct {
+	Upcoming types.Bool `tfsdk:"upcoming"`
+	Standing types.Bool `tfsdk:"standing"`
+	Resolved types.Bool `tfsdk:"resolved"`
+
+	Components types.List `tfsdk:"components"`
+}
+
+func (r *IssueListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue"
+}
+
+func (r *IssueListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Issues of the status page, optionally filtered by the following arguments.",
+
+		Attributes: map[string]schema.Attribute{
+			"upcoming": schema.BoolAttribute{
+				MarkdownDescription: "When true, returns only upcoming scheduled Issues.",
+				Optional:            true,
+			},
+			"resolved": schema.BoolAttribute{
+				MarkdownDescription: "When true, returns only resolved Issues.",
+				Optional:            true,
+			},
+			"standing": schema.BoolAttribute{
+				MarkdownDescription: "When true, returns only ongoing Issues.",
+				Optional:            true,
+			},
+			"components": schema.ListAttribute{
+				MarkdownDescription: "One or more Components to return Issues for.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *IssueListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResourceClient(req, resp)
+}
+
+func (r *IssueListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data IssueListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	var components *[]string
+	if !data.Components.IsNull() {
+		comps := []string{}
+		diags.Append(data.Components.ElementsAs(ctx, &comps, false)...)
+
+		components = &comps
+	}
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	limit := listPageSize
+	params := hundApiV1.GetAllIssuesParams{
+		Upcoming: data.Upcoming.ValueBoolPointer(),
+		Standing: data.Standing.ValueBoolPointer(),
+		Resolved: data.Resolved.ValueBoolPointer(),
+
+		Components: components,
+
+		Limit: &limit,
+	}
+
+	retrievePage := func(startingAfter *string, diags *diag.Diagnostics) ([]hundApiV1.Issue, bool) {
+		params.StartingAfter = startingAfter
+
+		rsp, err := r.client.GetAllIssues(ctx, &params)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund Issues",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		issues, err := hundApiV1.ParseGetAllIssuesResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund Issues",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		if issues.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(issues.StatusCode())+
+					"\nError: "+string(issues.Body),
+			)
+			return nil, false
+		}
+
+		return issues.HALJSON200.Data, issues.HALJSON200.HasMore
+	}
+
+	stream.Results = streamListResults(ctx, req, retrievePage, func(issue hundApiV1.Issue, result *list.ListResult) string {
+		result.DisplayName = i18nStringOriginal(issue.Title)
+
+		model, diag := models.ToIssueModel(ctx, issue)
+		result.Diagnostics.Append(diag...)
+
+		if result.Diagnostics.HasError() {
+			return issue.Id
+		}
+
+		setListResult(ctx, req, result, issue.Id, IssueResourceModel(model))
+
+		return issue.Id
+	})
+}
@@ -2,11 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,22 +32,41 @@ import (
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
 	"github.com/hundio/terraform-provider-hund/internal/models"
 	"github.com/hundio/terraform-provider-hund/internal/planmodifiers"
+	"github.com/hundio/terraform-provider-hund/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IssueResource{}
 var _ resource.ResourceWithConfigure = &IssueResource{}
 var _ resource.ResourceWithImportState = &IssueResource{}
+var _ resource.ResourceWithIdentity = &IssueResource{}
 var _ resource.ResourceWithConfigValidators = &IssueResource{}
 var _ resource.ResourceWithModifyPlan = &IssueResource{}
 
+// Private state keys used to track which Updates of an Issue were created by
+// the hund_issue resource, which others have already been reported, and which
+// are to be reported during the next plan.
+const (
+	issueManagedUpdatesKey  = "managed_updates"
+	issueSeenUpdatesKey     = "seen_updates"
+	issueExternalUpdatesKey = "external_updates"
+)
+
+// defaultResolveOnDestroyBody is the body of the Update posted by
+// resolve_on_destroy, when no other body is given.
+const defaultResolveOnDestroyBody = "This issue has been resolved."
+
 func NewIssueResource() resource.Resource {
 	return &IssueResource{}
 }
 
 // IssueResource defines the resource implementation.
 type IssueResource struct {
-	client *hundApiV1.Client
+	client  *hundApiV1.Client
+	locales []string
+
+	renderMarkdownLocally bool
+	readOnly              bool
 }
 
 // IssueResourceModel describes the resource data model.
@@ -65,10 +89,52 @@ func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_external_updates": schema.BoolAttribute{
+				MarkdownDescription: "When true, Updates of this Issue which were not created by this resource are accepted silently: they are not reported in plan warnings, and are left out of `updates`, so that they never conflict with any `updates` given in configuration. This includes both Updates posted outside Terraform (for example, by responders in the Hund UI) and those of `hund_issue_update` resources, which the provider cannot tell apart; the latter remain tracked by their own resources. When false, each such Update is reported once, in the first plan after it appears. Updates created by this resource are always retained in `updates`.",
+				Optional:            true,
+			},
 			"archive_on_destroy": schema.BoolAttribute{
 				MarkdownDescription: "When true, this Issue will not be destroyed from your status page if the resource is destroyed in your Terraform configuration. This option is **recommended** for maintaining a history on your status page of past Issues.",
 				Optional:            true,
 			},
+			"resolve_on_destroy": schema.SingleNestedAttribute{
+				MarkdownDescription: "When set, destroying this resource will not destroy the Issue from your status page. Instead, a final `resolved` Update is posted to the Issue (unless it is already resolved), leaving the Issue in your status page history.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"body": schema.StringAttribute{
+						MarkdownDescription: translationOriginalFieldMarkdownDescription("The body of the resolving Update, potentially with markdown formatting.") + " Defaults to `" + defaultResolveOnDestroyBody + "` if neither `body`, `body_translations`, nor `template` are given.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("body_translations"),
+								path.MatchRelative().AtParent().AtName("template"),
+							),
+						},
+					},
+					"body_translations": schema.MapAttribute{
+						MarkdownDescription: translationFieldMarkdownDescription("The body of the resolving Update, potentially with markdown formatting."),
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Map{
+							validators.TranslationKeys(),
+							mapvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("template"),
+							),
+						},
+					},
+					"template": schema.SingleNestedAttribute{
+						MarkdownDescription: "An IssueTemplate (of `kind = \"update\"`) to apply to the resolving Update.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"issue_template_id": schema.StringAttribute{
+								MarkdownDescription: "The ObjectId of the IssueTemplate to apply.",
+								Required:            true,
+							},
+							"variables": issueTemplateApplicationSchema().Attributes["variables"],
+						},
+					},
+				},
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: createdAtFieldMarkdownDescription("Issue"),
 				Computed:            true,
@@ -139,6 +205,9 @@ func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -148,6 +217,9 @@ func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -361,6 +433,9 @@ func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Optional:            true,
 							Computed:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.Map{
+								validators.TranslationKeys(),
+							},
 						},
 						"body_html_translations": schema.MapAttribute{
 							MarkdownDescription: translationFieldMarkdownDescription("An HTML rendered view of the markdown in `body`."),
@@ -406,16 +481,28 @@ func (r *IssueResource) ConfigValidators(ctx context.Context) []resource.ConfigV
 			path.MatchRoot("ended_at"),
 			path.MatchRoot("updates"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("archive_on_destroy"),
+			path.MatchRoot("resolve_on_destroy"),
+		),
+		validators.IssueScheduleOrder(),
 	}
 }
 
 func (r *IssueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkTranslationLocales(ctx, r.locales, req.Config, &resp.Diagnostics)
+
+	if r.renderMarkdownLocally {
+		// Predict the rendering once the plan is otherwise complete.
+		defer predictMarkdownHtml(ctx, &resp.Plan, "body", &resp.Diagnostics)
+	}
+
 	if req.Plan.Raw.IsNull() {
 		var data IssueResourceModel
 
 		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-		if !data.ArchiveOnDestroy.ValueBool() {
+		if !data.ArchiveOnDestroy.ValueBool() && data.ResolveOnDestroy == nil {
 			resp.Diagnostics.Append(IssueAndUpdateDestructionWarning())
 		}
 
@@ -423,6 +510,14 @@ func (r *IssueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 	}
 
 	if req.State.Raw.IsNull() {
+		var startsAt types.String
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schedule").AtName("starts_at"), &startsAt)...)
+
+		if starts, err := time.Parse(time.RFC3339, startsAt.ValueString()); err == nil && starts.Before(time.Now()) {
+			resp.Diagnostics.Append(RetroactiveScheduleWarning(startsAt.ValueString()))
+		}
+
 		return
 	}
 
@@ -436,6 +531,14 @@ func (r *IssueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
+	if !plan.AdoptExternalUpdates.ValueBool() {
+		external := privateUpdateIds(ctx, req.Private, issueExternalUpdatesKey, &resp.Diagnostics)
+
+		if len(external) > 0 {
+			resp.Diagnostics.Append(ExternalIssueUpdatesWarning(filterUpdates(state.Updates, external)))
+		}
+	}
+
 	planmodifiers.PlanModifyIssueTemplateIssueApplication(ctx, path.Root("template"), state.Template, plan.Template, resp)
 
 	var templateState, templatePlan attr.Value
@@ -475,27 +578,42 @@ func (r *IssueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 	}
 }
 
+func (r *IssueResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ObjectId of this Issue.")
+}
+
 func (r *IssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*hundApiV1.Client)
+	data, ok := req.ProviderData.(*HundResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hundApiV1.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.locales = data.Locales
+	r.renderMarkdownLocally = data.RenderMarkdownLocally
 }
 
 func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_issue"))
+		return
+	}
+
 	var data IssueResourceModel
 
 	// Read Terraform plan data into the model
@@ -635,7 +753,17 @@ func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if r.renderMarkdownLocally {
+		reconcileMarkdownHtml("body", data.BodyHtml, &newState.BodyHtml, data.BodyHtmlTranslations, &newState.BodyHtmlTranslations, &resp.Diagnostics)
+	}
+
 	newState.ArchiveOnDestroy = data.ArchiveOnDestroy
+	newState.ResolveOnDestroy = data.ResolveOnDestroy
+	newState.AdoptExternalUpdates = data.AdoptExternalUpdates
+
+	setPrivateUpdateIds(ctx, resp.Private, issueManagedUpdatesKey, updateIds(newState.Updates), &resp.Diagnostics)
+	setPrivateUpdateIds(ctx, resp.Private, issueSeenUpdatesKey, []string{}, &resp.Diagnostics)
+	setPrivateUpdateIds(ctx, resp.Private, issueExternalUpdatesKey, []string{}, &resp.Diagnostics)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -643,9 +771,13 @@ func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	var data IssueResourceModel
 
 	// Read Terraform prior state data into the model
@@ -695,12 +827,25 @@ func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	newState.ArchiveOnDestroy = data.ArchiveOnDestroy
+	newState.ResolveOnDestroy = data.ResolveOnDestroy
+	newState.AdoptExternalUpdates = data.AdoptExternalUpdates
+
+	r.trackUpdates(ctx, req.Private, resp.Private, &newState, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *IssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_issue"))
+		return
+	}
+
 	var data, config IssueResourceModel
 
 	// Read Terraform plan data into the model
@@ -825,13 +970,30 @@ func (r *IssueResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if r.renderMarkdownLocally {
+		reconcileMarkdownHtml("body", data.BodyHtml, &newState.BodyHtml, data.BodyHtmlTranslations, &newState.BodyHtmlTranslations, &resp.Diagnostics)
+	}
+
 	newState.ArchiveOnDestroy = data.ArchiveOnDestroy
+	newState.ResolveOnDestroy = data.ResolveOnDestroy
+	newState.AdoptExternalUpdates = data.AdoptExternalUpdates
+
+	r.trackUpdates(ctx, req.Private, resp.Private, &newState, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_issue"))
+		return
+	}
+
 	var data IssueResourceModel
 
 	// Read Terraform prior state data into the model
@@ -845,6 +1007,11 @@ func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if data.ResolveOnDestroy != nil {
+		r.resolveIssue(ctx, data, &resp.Diagnostics)
+		return
+	}
+
 	rsp, err := r.client.DeleteAIssue(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -871,8 +1038,209 @@ func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// resolveIssue posts a final resolving Update to the Issue, according to its
+// resolve_on_destroy settings.
+func (r *IssueResource) resolveIssue(ctx context.Context, data IssueResourceModel, diags *diag.Diagnostics) {
+	if data.Resolved.ValueBool() {
+		return
+	}
+
+	resolved := hundApiV1.UPDATELABELResolved
+	form := hundApiV1.UpdateFormCreate{}
+
+	if data.ResolveOnDestroy.Template != nil {
+		templateForm := hundApiV1.IssueTemplateApplicationFormCreate{}
+
+		prepareIssueTemplateApplicationUpdate(ctx, models.IssueTemplateApplicationUpdateModel{
+			IssueTemplateId:  data.ResolveOnDestroy.Template.IssueTemplateId,
+			Body:             types.StringUnknown(),
+			BodyTranslations: types.MapUnknown(types.StringType),
+			Label:            types.StringValue(string(resolved)),
+			Schema:           types.MapUnknown(types.ObjectType{}),
+			Variables:        data.ResolveOnDestroy.Template.Variables,
+		}, &templateForm, diags)
+
+		if diags.HasError() {
+			return
+		}
+
+		opaqueForm := hundApiV1.UpdateFormCreate_Template{}
+		err := opaqueForm.FromUpdateFormCreateTemplate1(templateForm)
+		if err != nil {
+			diags.AddError(
+				"Template conversion error",
+				"Got error encoding IssueTemplateApplication: "+err.Error(),
+			)
+			return
+		}
+
+		form.Template = &opaqueForm
+	} else {
+		bodyValue := data.ResolveOnDestroy.Body
+
+		if bodyValue.IsNull() && data.ResolveOnDestroy.BodyTranslations.IsNull() {
+			bodyValue = types.StringValue(defaultResolveOnDestroyBody)
+		}
+
+		body, err := hundApiV1.ToI18nStringPtr(bodyValue, data.ResolveOnDestroy.BodyTranslations)
+		if err != nil {
+			diags.Append(models.I18nStringError(err))
+			return
+		}
+
+		form.Body = hundApiV1.DblPtr(body)
+		form.Label = hundApiV1.DblPtr(&resolved)
+	}
+
+	rsp, err := r.client.CreateAUpdate(ctx, data.Id.ValueString(), form)
+	if err != nil {
+		diags.AddError(
+			"Unable to Create Hund Issue Update",
+			err.Error(),
+		)
+		return
+	}
+
+	if rsp.StatusCode == 404 {
+		return
+	}
+
+	update, err := hundApiV1.ParseCreateAUpdateResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Issue Update",
+			err.Error(),
+		)
+		return
+	}
+
+	if update.StatusCode() != 201 {
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-200 status code: "+fmt.Sprint(update.StatusCode())+
+				"\nError: "+string(update.Body),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "resolved issue instead of deleting", map[string]interface{}{
+		"issue": data.Id.ValueString(),
+	})
+}
+
+// trackUpdates records which Updates of the Issue, not created by this
+// resource, have appeared since it was last read, so that they are reported
+// during the next plan. Each such Update is then moved to the seen Updates,
+// and is not reported again; this keeps Updates of hund_issue_update
+// resources from being reported on every plan. When adopt_external_updates
+// is set, Updates not created by this resource are dropped from the given
+// state instead.
+func (r *IssueResource) trackUpdates(ctx context.Context, prior privateStateData, private privateStateData, state *models.IssueModel, diags *diag.Diagnostics) {
+	current := updateIds(state.Updates)
+
+	managed, found := lookupPrivateUpdateIds(ctx, prior, issueManagedUpdatesKey, diags)
+	if !found {
+		// Imported, or managed by an earlier version of this provider:
+		// consider all existing Updates to be managed by this resource.
+		managed = current
+	}
+
+	seen := privateUpdateIds(ctx, prior, issueSeenUpdatesKey, diags)
+	if seen == nil {
+		seen = []string{}
+	}
+
+	external := []string{}
+
+	for _, id := range current {
+		if !slices.Contains(managed, id) && !slices.Contains(seen, id) {
+			external = append(external, id)
+		}
+	}
+
+	seen = append(seen, external...)
+
+	if state.AdoptExternalUpdates.ValueBool() {
+		external = []string{}
+		state.Updates = filterUpdates(state.Updates, managed)
+	}
+
+	setPrivateUpdateIds(ctx, private, issueManagedUpdatesKey, managed, diags)
+	setPrivateUpdateIds(ctx, private, issueSeenUpdatesKey, seen, diags)
+	setPrivateUpdateIds(ctx, private, issueExternalUpdatesKey, external, diags)
+}
+
+// privateStateData is the subset of resource private state used to track
+// Updates.
+type privateStateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func lookupPrivateUpdateIds(ctx context.Context, private privateStateData, key string, diags *diag.Diagnostics) ([]string, bool) {
+	value, d := private.GetKey(ctx, key)
+	diags.Append(d...)
+
+	if len(value) == 0 {
+		return nil, false
+	}
+
+	ids := []string{}
+
+	if err := json.Unmarshal(value, &ids); err != nil {
+		diags.AddError(
+			"Unable to Decode Private State",
+			"Got error decoding "+key+": "+err.Error(),
+		)
+		return nil, false
+	}
+
+	return ids, true
+}
+
+func privateUpdateIds(ctx context.Context, private privateStateData, key string, diags *diag.Diagnostics) []string {
+	ids, _ := lookupPrivateUpdateIds(ctx, private, key, diags)
+
+	return ids
+}
+
+func setPrivateUpdateIds(ctx context.Context, private privateStateData, key string, ids []string, diags *diag.Diagnostics) {
+	value, err := json.Marshal(ids)
+	if err != nil {
+		diags.AddError(
+			"Unable to Encode Private State",
+			"Got error encoding "+key+": "+err.Error(),
+		)
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, key, value)...)
+}
+
+func updateIds(updates []models.UpdateModel) []string {
+	ids := make([]string, 0, len(updates))
+
+	for _, update := range updates {
+		ids = append(ids, update.Id.ValueString())
+	}
+
+	return ids
+}
+
+func filterUpdates(updates []models.UpdateModel, ids []string) []models.UpdateModel {
+	filtered := []models.UpdateModel{}
+
+	for _, update := range updates {
+		if slices.Contains(ids, update.Id.ValueString()) {
+			filtered = append(filtered, update)
+		}
+	}
+
+	return filtered
+}
+
 func (r *IssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func prepareIssueTemplateApplication(ctx context.Context, model models.IssueTemplateApplicationIssueModel, form *hundApiV1.IssueTemplateApplicationIssueFormCreate, diags *diag.Diagnostics) {
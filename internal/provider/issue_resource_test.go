@@ -7,9 +7,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+	"github.com/hundio/terraform-provider-hund/internal/validators"
 )
 
 func TestAccIssueResource(t *testing.T) {
@@ -66,6 +73,62 @@ func TestAccIssueResource_archive(t *testing.T) {
 	})
 }
 
+func TestAccIssueResource_resolve(t *testing.T) {
+	var apiIssue hundApiV1.Issue
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIssueResourceConfig_resolve(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccIssueResourceCheckExistence("hund_issue.test", &apiIssue),
+					resource.TestCheckResourceAttr("hund_issue.test", "resolve_on_destroy.body", "All clear."),
+				),
+			},
+			// Resolve Issue, and delete from State only
+			{
+				Config: testAccIssueResourceConfig_resolve(true),
+				Check:  testAccIssueResourceCheckResolution(&apiIssue),
+			},
+		},
+	})
+}
+
+func TestAccIssueResource_adoptExternalUpdates(t *testing.T) {
+	var apiIssue hundApiV1.Issue
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIssueResourceConfig_adoptExternalUpdates(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccIssueResourceCheckExistence("hund_issue.test", &apiIssue),
+					resource.TestCheckResourceAttr("hund_issue.test", "adopt_external_updates", "true"),
+					resource.TestCheckResourceAttr("hund_issue.test", "updates.#", "0"),
+				),
+			},
+			// Post an Update outside Terraform, which should be adopted
+			{
+				PreConfig: func() {
+					if err := testAccIssueResourceCreateExternalUpdate(apiIssue.Id); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIssueResourceConfig_adoptExternalUpdates(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_issue.test", "updates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIssueResource_scheduled(t *testing.T) {
 	datum := time.Now().AddDate(0, 0, 1)
 
@@ -177,6 +240,7 @@ resource "hund_group" "test" {
 }
 
 resource "hund_component" "test" {
+	deletion_protection = false
 	group = hund_group.test.id
 	name = "Test Component"
 
@@ -214,6 +278,42 @@ func testAccIssueResourceConfig_archive(archive bool) string {
 	}
 
 	resource "hund_component" "test" {
+		deletion_protection = false
+		group = hund_group.test.id
+		name = "Test Component"
+
+		watchdog = {service = {manual = {}}}
+	}
+
+	%[1]v
+	`, issueResource)
+}
+
+func testAccIssueResourceConfig_resolve(resolve bool) string {
+	var issueResource string
+
+	if !resolve {
+		issueResource = `
+		resource "hund_issue" "test" {
+			resolve_on_destroy = {
+				body = "All clear."
+			}
+
+			component_ids = [hund_component.test.id]
+
+			title = "Test Issue"
+			body = "Test Body"
+		}
+		`
+	}
+
+	return providerConfig + fmt.Sprintf(`
+	resource "hund_group" "test" {
+		name = "Test Group"
+	}
+
+	resource "hund_component" "test" {
+		deletion_protection = false
 		group = hund_group.test.id
 		name = "Test Component"
 
@@ -224,6 +324,31 @@ func testAccIssueResourceConfig_archive(archive bool) string {
 	`, issueResource)
 }
 
+func testAccIssueResourceConfig_adoptExternalUpdates() string {
+	return providerConfig + `
+	resource "hund_group" "test" {
+		name = "Test Group"
+	}
+
+	resource "hund_component" "test" {
+		deletion_protection = false
+		group = hund_group.test.id
+		name = "Test Component"
+
+		watchdog = {service = {manual = {}}}
+	}
+
+	resource "hund_issue" "test" {
+		adopt_external_updates = true
+
+		component_ids = [hund_component.test.id]
+
+		title = "Test Issue"
+		body = "Test Body"
+	}
+	`
+}
+
 func testAccIssueResourceConfig_scheduled(datum time.Time) string {
 	return providerConfig + fmt.Sprintf(`
 	resource "hund_group" "test" {
@@ -231,6 +356,7 @@ func testAccIssueResourceConfig_scheduled(datum time.Time) string {
 	}
 
 	resource "hund_component" "test" {
+		deletion_protection = false
 		group = hund_group.test.id
 		name = "Test Component"
 
@@ -258,6 +384,7 @@ func testAccIssueResourceConfig_retrospective(began time.Time, ended time.Time)
 	}
 
 	resource "hund_component" "test" {
+		deletion_protection = false
 		group = hund_group.test.id
 		name = "Test Component"
 
@@ -289,6 +416,7 @@ func testAccIssueResourceConfig_template(overrideBody bool) string {
 	}
 
 	resource "hund_component" "test" {
+		deletion_protection = false
 		group = hund_group.test.id
 		name = "Test Component"
 
@@ -364,6 +492,46 @@ func testAccIssueResourceCheckArchival(apiIssue *hundApiV1.Issue) resource.TestC
 	}
 }
 
+func testAccIssueResourceCheckResolution(apiIssue *hundApiV1.Issue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		issue, err := testAccIssueResourceRequestApiIssue(apiIssue.Id)
+		if err != nil {
+			return err
+		}
+
+		if !issue.Resolved {
+			return fmt.Errorf("expected issue %s to be resolved", apiIssue.Id)
+		}
+
+		return nil
+	}
+}
+
+func testAccIssueResourceCreateExternalUpdate(issueId string) error {
+	client, err := sharedClientForDomain("default")
+	if err != nil {
+		return err
+	}
+
+	body, err := hundApiV1.ToI18nStringPtr(types.StringValue("Posted outside Terraform."), types.MapNull(types.StringType))
+	if err != nil {
+		return err
+	}
+
+	rsp, err := client.CreateAUpdate(context.Background(), issueId, hundApiV1.UpdateFormCreate{
+		Body: hundApiV1.DblPtr(body),
+	})
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode != 201 {
+		return fmt.Errorf("could not create update for issue %s (status code: %v)", issueId, rsp.StatusCode)
+	}
+
+	return nil
+}
+
 func testAccIssueResourceRequestApiIssue(id string) (*hundApiV1.Issue, error) {
 	client, err := sharedClientForDomain("default")
 	if err != nil {
@@ -389,3 +557,195 @@ func testAccIssueResourceRequestApiIssue(id string) (*hundApiV1.Issue, error) {
 
 	return issue.HALJSON200, nil
 }
+
+func TestAccIssueResource_scheduleOrder(t *testing.T) {
+	datum := time.Now().AddDate(0, 0, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIssueResourceConfig_scheduleOrder(datum, "-2h"),
+				ExpectError: regexp.MustCompile("Issue Timestamps Out of Order"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccIssueResourceConfig_scheduleOrder(datum time.Time, duration string) string {
+	return providerConfig + fmt.Sprintf(`
+	resource "hund_issue" "test" {
+		component_ids = ["5d72d51f8fbb65b5d3a587e1"]
+
+		title = "Test Scheduled Issue"
+		body = "Test Body"
+
+		schedule = {
+			starts_at = %[1]q
+			ends_at = timeadd(%[1]q, %[2]q)
+		}
+	}
+	`, testToTfTimestamp(datum), duration)
+}
+
+func TestIssueScheduleOrderValidator(t *testing.T) {
+	ctx := context.Background()
+
+	r := NewIssueResource()
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	scheduleType := objectType.AttributeTypes["schedule"].(tftypes.Object)
+
+	config := func(attributes map[string]string, schedule map[string]string) tfsdk.Config {
+		values := map[string]tftypes.Value{}
+
+		for name, value := range attributes {
+			values[name] = tftypes.NewValue(tftypes.String, value)
+		}
+
+		if schedule != nil {
+			scheduleValues := map[string]tftypes.Value{}
+
+			for name, value := range schedule {
+				scheduleValues[name] = tftypes.NewValue(tftypes.String, value)
+			}
+
+			values["schedule"] = testObjectValue(scheduleType, scheduleValues)
+		}
+
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: testObjectValue(objectType, values)}
+	}
+
+	cases := []struct {
+		name       string
+		attributes map[string]string
+		schedule   map[string]string
+		expected   string
+	}{
+		{"unscheduled", nil, nil, ""},
+		{"ordered schedule", nil, map[string]string{
+			"notify_subscribers_at": "2025-01-01T00:00:00Z",
+			"starts_at":             "2025-01-02T00:00:00Z",
+			"ends_at":               "2025-01-02T02:00:00Z",
+		}, ""},
+		{"schedule ends before start", nil, map[string]string{
+			"starts_at": "2025-01-02T00:00:00Z",
+			"ends_at":   "2025-01-01T00:00:00Z",
+		}, "schedule.ends_at"},
+		{"notification after start", nil, map[string]string{
+			"notify_subscribers_at": "2025-01-03T00:00:00Z",
+			"starts_at":             "2025-01-02T00:00:00Z",
+			"ends_at":               "2025-01-02T02:00:00Z",
+		}, "schedule.starts_at"},
+		{"ended before schedule", map[string]string{"ended_at": "2025-01-01T00:00:00Z"}, map[string]string{
+			"starts_at": "2025-01-02T00:00:00Z",
+			"ends_at":   "2025-01-02T02:00:00Z",
+		}, "ended_at"},
+		{"ended before began", map[string]string{
+			"began_at": "2025-01-02T00:00:00Z",
+			"ended_at": "2025-01-01T00:00:00Z",
+		}, nil, "ended_at"},
+	}
+
+	for _, c := range cases {
+		resp := fwresource.ValidateConfigResponse{}
+		validators.IssueScheduleOrder().ValidateResource(ctx, fwresource.ValidateConfigRequest{Config: config(c.attributes, c.schedule)}, &resp)
+
+		var actual string
+
+		for _, d := range resp.Diagnostics.Errors() {
+			if d, ok := d.(diag.DiagnosticWithPath); ok {
+				actual = d.Path().String()
+			}
+		}
+
+		if actual != c.expected || len(resp.Diagnostics) > 1 {
+			t.Errorf("%s: expected an error at %q, got %v", c.name, c.expected, resp.Diagnostics)
+		}
+	}
+}
+
+// testPrivateState is an in-memory privateStateData.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestIssueTrackUpdates(t *testing.T) {
+	ctx := context.Background()
+	r := &IssueResource{}
+
+	updates := func(ids ...string) []models.UpdateModel {
+		result := []models.UpdateModel{}
+
+		for _, id := range ids {
+			result = append(result, models.UpdateModel{Id: types.StringValue(id)})
+		}
+
+		return result
+	}
+
+	var diags diag.Diagnostics
+
+	// Imported: every existing Update is considered managed.
+	private := testPrivateState{}
+	state := models.IssueModel{Updates: updates("a")}
+	r.trackUpdates(ctx, private, private, &state, &diags)
+
+	if external := privateUpdateIds(ctx, private, issueExternalUpdatesKey, &diags); len(external) != 0 {
+		t.Errorf("expected no external Updates after import, got %v", external)
+	}
+
+	// Updates not created by the resource are reported once, after the read
+	// in which they first appear.
+	state = models.IssueModel{Updates: updates("a", "b")}
+	r.trackUpdates(ctx, private, private, &state, &diags)
+
+	if external := privateUpdateIds(ctx, private, issueExternalUpdatesKey, &diags); len(external) != 1 || external[0] != "b" {
+		t.Errorf("expected b to be reported as external, got %v", external)
+	}
+
+	if len(state.Updates) != 2 {
+		t.Errorf("expected every Update to be kept in state, got %v", updateIds(state.Updates))
+	}
+
+	state = models.IssueModel{Updates: updates("a", "b", "c")}
+	r.trackUpdates(ctx, private, private, &state, &diags)
+
+	if external := privateUpdateIds(ctx, private, issueExternalUpdatesKey, &diags); len(external) != 1 || external[0] != "c" {
+		t.Errorf("expected only c to be reported as external, got %v", external)
+	}
+
+	state = models.IssueModel{Updates: updates("a", "b", "c")}
+	r.trackUpdates(ctx, private, private, &state, &diags)
+
+	if external := privateUpdateIds(ctx, private, issueExternalUpdatesKey, &diags); len(external) != 0 {
+		t.Errorf("expected Updates already reported not to be reported again, got %v", external)
+	}
+
+	state = models.IssueModel{Updates: updates("a", "b", "c"), AdoptExternalUpdates: types.BoolValue(true)}
+	r.trackUpdates(ctx, private, private, &state, &diags)
+
+	if external := privateUpdateIds(ctx, private, issueExternalUpdatesKey, &diags); len(external) != 0 {
+		t.Errorf("expected no external Updates once adopted, got %v", external)
+	}
+
+	if ids := updateIds(state.Updates); len(ids) != 1 || ids[0] != "a" {
+		t.Errorf("expected adopted Updates to be left out of state, got %v", ids)
+	}
+
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &IssueTemplateListResource{}
+var _ list.ListResourceWithConfigure = &IssueTemplateListResource{}
+
+func NewIssueTemplateListResource() list.ListResource {
+	return &IssueTemplateListResource{}
+}
+
+// IssueTemplateListResource defines the list resource implementation.
+type IssueTemplateListResource struct {
+	client *hundApiV1.Client
+}
+
+// IssueTemplateListResourceModel describes the list resource data model.
+type IssueTemplateListResourceModel struct {
+	Kind types.String `tfsdk:"kind"`
+}
+
+func (r *IssueTemplateListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_template"
+}
+
+func (r *IssueTemplateListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the IssueTemplates of the status page, optionally filtered by the following arguments.",
+
+		Attributes: map[string]schema.Attribute{
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "Return only IssueTemplates for the given kind. Either `issue` or `update`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("issue", "update"),
+				},
+			},
+		},
+	}
+}
+
+func (r *IssueTemplateListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResourceClient(req, resp)
+}
+
+func (r *IssueTemplateListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data IssueTemplateListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	limit := listPageSize
+	params := hundApiV1.GetAllIssueTemplatesParams{
+		Kind: (*hundApiV1.GetAllIssueTemplatesParamsKind)(data.Kind.ValueStringPointer()),
+
+		Limit: &limit,
+	}
+
+	retrievePage := func(startingAfter *string, diags *diag.Diagnostics) ([]hundApiV1.IssueTemplate, bool) {
+		params.StartingAfter = startingAfter
+
+		rsp, err := r.client.GetAllIssueTemplates(ctx, &params)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund IssueTemplates",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		templates, err := hundApiV1.ParseGetAllIssueTemplatesResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund IssueTemplates",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		if templates.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(templates.StatusCode())+
+					"\nError: "+string(templates.Body),
+			)
+			return nil, false
+		}
+
+		return templates.HALJSON200.Data, templates.HALJSON200.HasMore
+	}
+
+	stream.Results = streamListResults(ctx, req, retrievePage, func(template hundApiV1.IssueTemplate, result *list.ListResult) string {
+		result.DisplayName = template.Name
+
+		model, diag := models.ToIssueTemplateModel(template)
+		result.Diagnostics.Append(diag...)
+
+		if result.Diagnostics.HasError() {
+			return template.Id
+		}
+
+		setListResult(ctx, req, result, template.Id, IssueTemplateResourceModel(model))
+
+		return template.Id
+	})
+}
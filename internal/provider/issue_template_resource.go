@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,6 +26,7 @@ import (
 var _ resource.Resource = &IssueTemplateResource{}
 var _ resource.ResourceWithConfigure = &IssueTemplateResource{}
 var _ resource.ResourceWithImportState = &IssueTemplateResource{}
+var _ resource.ResourceWithIdentity = &IssueTemplateResource{}
 var _ resource.ResourceWithConfigValidators = &IssueTemplateResource{}
 var _ resource.ResourceWithModifyPlan = &IssueTemplateResource{}
 
@@ -34,7 +36,11 @@ func NewIssueTemplateResource() resource.Resource {
 
 // IssueTemplateResource defines the resource implementation.
 type IssueTemplateResource struct {
-	client *hundApiV1.Client
+	client  *hundApiV1.Client
+	locales []string
+
+	conflictDetection bool
+	readOnly          bool
 }
 
 // IssueTemplateResourceModel describes the resource data model.
@@ -106,6 +112,9 @@ func (r *IssueTemplateResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -115,6 +124,9 @@ func (r *IssueTemplateResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -135,24 +147,31 @@ func (r *IssueTemplateResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *IssueTemplateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ObjectId of this IssueTemplate.")
+}
+
 func (r *IssueTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*hundApiV1.Client)
+	data, ok := req.ProviderData.(*HundResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hundApiV1.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.locales = data.Locales
+	r.conflictDetection = data.ConflictDetection
 }
 
 func (r *IssueTemplateResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -170,6 +189,8 @@ func (r *IssueTemplateResource) ConfigValidators(ctx context.Context) []resource
 }
 
 func (r *IssueTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkTranslationLocales(ctx, r.locales, req.Config, &resp.Diagnostics)
+
 	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
 		return
 	}
@@ -205,6 +226,14 @@ func (r *IssueTemplateResource) ModifyPlan(ctx context.Context, req resource.Mod
 }
 
 func (r *IssueTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue_template", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_issue_template"))
+		return
+	}
+
 	var data IssueTemplateResourceModel
 
 	// Read Terraform plan data into the model
@@ -280,9 +309,13 @@ func (r *IssueTemplateResource) Create(ctx context.Context, req resource.CreateR
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *IssueTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue_template", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	var data IssueTemplateResourceModel
 
 	// Read Terraform prior state data into the model
@@ -333,18 +366,36 @@ func (r *IssueTemplateResource) Read(ctx context.Context, req resource.ReadReque
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *IssueTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data IssueTemplateResourceModel
+	ctx, span := startResourceSpan(ctx, "hund_issue_template", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_issue_template"))
+		return
+	}
+
+	var data, state IssueTemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if r.conflictDetection {
+		r.detectConflict(ctx, state, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	title, err := hundApiV1.ToI18nStringPtr(data.Title, data.TitleTranslations)
 	if err != nil {
 		resp.Diagnostics.Append(models.I18nStringError(err))
@@ -413,9 +464,18 @@ func (r *IssueTemplateResource) Update(ctx context.Context, req resource.UpdateR
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *IssueTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue_template", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_issue_template"))
+		return
+	}
+
 	var data IssueTemplateResourceModel
 
 	// Read Terraform prior state data into the model
@@ -451,6 +511,52 @@ func (r *IssueTemplateResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+// detectConflict reports an error when the Issue Template was modified
+// outside Terraform since the prior state was read.
+func (r *IssueTemplateResource) detectConflict(ctx context.Context, state IssueTemplateResourceModel, diags *diag.Diagnostics) {
+	rsp, err := r.client.RetrieveAIssueTemplate(ctx, state.Id.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Hund Issue Template",
+			err.Error(),
+		)
+		return
+	}
+
+	template, err := hundApiV1.ParseRetrieveAIssueTemplateResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Issue Template",
+			err.Error(),
+		)
+		return
+	}
+
+	if template.StatusCode() != 200 {
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-200 status code: "+fmt.Sprint(template.StatusCode())+
+				"\nError: "+string(template.Body),
+		)
+		return
+	}
+
+	remote, diag := models.ToIssueTemplateModel(*template.HALJSON200)
+	diags.Append(diag...)
+
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(detectConflict("Issue Template", state, IssueTemplateResourceModel(remote))...)
+}
+
 func (r *IssueTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := resolveIssueTemplateImportId(ctx, r.client, importIdentityId(ctx, req, &resp.Diagnostics), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -64,7 +64,7 @@ func TestAccIssueTemplateResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccIssueTemplateResourceConfig("Template One"),
+				Config: testAccIssueTemplateResourceConfig(t.Name() + " One"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("hund_issue_template.test", "body_translations.en", "Test Template Body {{vars.summary}}"),
 				),
@@ -75,9 +75,16 @@ func TestAccIssueTemplateResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "hund_issue_template.test",
+				ImportState:       true,
+				ImportStateId:     t.Name() + " One",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
-				Config: testAccIssueTemplateResourceConfigI18n("Template Two"),
+				Config: testAccIssueTemplateResourceConfigI18n(t.Name() + " Two"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("hund_issue_template.test", "body", "different summary: {{vars.unused}}"),
 				),
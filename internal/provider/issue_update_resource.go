@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -23,12 +24,14 @@ import (
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
 	"github.com/hundio/terraform-provider-hund/internal/models"
 	"github.com/hundio/terraform-provider-hund/internal/planmodifiers"
+	"github.com/hundio/terraform-provider-hund/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IssueUpdateResource{}
 var _ resource.ResourceWithConfigure = &IssueUpdateResource{}
 var _ resource.ResourceWithImportState = &IssueUpdateResource{}
+var _ resource.ResourceWithIdentity = &IssueUpdateResource{}
 var _ resource.ResourceWithConfigValidators = &IssueUpdateResource{}
 var _ resource.ResourceWithModifyPlan = &IssueUpdateResource{}
 
@@ -38,7 +41,11 @@ func NewIssueUpdateResource() resource.Resource {
 
 // IssueUpdateResource defines the resource implementation.
 type IssueUpdateResource struct {
-	client *hundApiV1.Client
+	client  *hundApiV1.Client
+	locales []string
+
+	renderMarkdownLocally bool
+	readOnly              bool
 }
 
 // IssueUpdateResourceModel describes the resource data model.
@@ -130,6 +137,9 @@ func (r *IssueUpdateResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -175,6 +185,13 @@ func (r *IssueUpdateResource) ConfigValidators(ctx context.Context) []resource.C
 }
 
 func (r *IssueUpdateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkTranslationLocales(ctx, r.locales, req.Config, &resp.Diagnostics)
+
+	if r.renderMarkdownLocally {
+		// Predict the rendering once the plan is otherwise complete.
+		defer predictMarkdownHtml(ctx, &resp.Plan, "body", &resp.Diagnostics)
+	}
+
 	if req.Plan.Raw.IsNull() {
 		var data IssueUpdateResourceModel
 
@@ -245,27 +262,53 @@ func (r *IssueUpdateResource) ModifyPlan(ctx context.Context, req resource.Modif
 	}
 }
 
+func (r *IssueUpdateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ObjectId of this Update.",
+				RequiredForImport: true,
+			},
+			"issue_id": identityschema.StringAttribute{
+				Description:       "The ObjectId of the Issue that this Update pertains to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *IssueUpdateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*hundApiV1.Client)
+	data, ok := req.ProviderData.(*HundResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hundApiV1.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.locales = data.Locales
+	r.renderMarkdownLocally = data.RenderMarkdownLocally
 }
 
 func (r *IssueUpdateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue_update", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_issue_update"))
+		return
+	}
+
 	var data, config IssueUpdateResourceModel
 
 	// Read Terraform plan data into the model
@@ -362,6 +405,10 @@ func (r *IssueUpdateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if r.renderMarkdownLocally {
+		reconcileMarkdownHtml("body", data.BodyHtml, &newState.BodyHtml, data.BodyHtmlTranslations, &newState.BodyHtmlTranslations, &resp.Diagnostics)
+	}
+
 	newState.ArchiveOnDestroy = data.ArchiveOnDestroy
 
 	// Write logs using the tflog package
@@ -370,9 +417,13 @@ func (r *IssueUpdateResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIssueUpdateIdentity(ctx, resp.Identity, newState.Id, newState.IssueId)...)
 }
 
 func (r *IssueUpdateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue_update", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	var data IssueUpdateResourceModel
 
 	// Read Terraform prior state data into the model
@@ -429,9 +480,18 @@ func (r *IssueUpdateResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIssueUpdateIdentity(ctx, resp.Identity, newState.Id, newState.IssueId)...)
 }
 
 func (r *IssueUpdateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue_update", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_issue_update"))
+		return
+	}
+
 	var data, config IssueUpdateResourceModel
 
 	// Read Terraform plan data into the model
@@ -527,13 +587,26 @@ func (r *IssueUpdateResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	if r.renderMarkdownLocally {
+		reconcileMarkdownHtml("body", data.BodyHtml, &newState.BodyHtml, data.BodyHtmlTranslations, &newState.BodyHtmlTranslations, &resp.Diagnostics)
+	}
+
 	newState.ArchiveOnDestroy = data.ArchiveOnDestroy
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIssueUpdateIdentity(ctx, resp.Identity, newState.Id, newState.IssueId)...)
 }
 
 func (r *IssueUpdateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_issue_update", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_issue_update"))
+		return
+	}
+
 	var data IssueUpdateResourceModel
 
 	// Read Terraform prior state data into the model
@@ -578,6 +651,20 @@ func (r *IssueUpdateResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *IssueUpdateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		var identity IssueUpdateIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_id"), identity.IssueId)...)
+		return
+	}
+
 	issueId, id, ok := strings.Cut(req.ID, "/")
 
 	if !ok {
@@ -586,6 +673,7 @@ func (r *IssueUpdateResource) ImportState(ctx context.Context, req resource.Impo
 			"To properly import an Issue Update, please provide both the Issue ID, a"+
 				" forward slash, and finally the Issue Update ID: <ISSUE_ID>/<UPDATE_ID>",
 		)
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
@@ -9,7 +9,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
 )
 
@@ -46,6 +49,32 @@ func TestAccIssueUpdateResource(t *testing.T) {
 	})
 }
 
+func TestAccIssueUpdateResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueUpdateResourceConfig("body"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("hund_issue_update.test", tfjsonpath.New("id")),
+					statecheck.ExpectIdentityValueMatchesState("hund_issue_update.test", tfjsonpath.New("issue_id")),
+				},
+			},
+			// Import by identity testing
+			{
+				ResourceName:    "hund_issue_update.test",
+				Config:          testAccIssueUpdateResourceConfig("body"),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccIssueUpdateResource_archive(t *testing.T) {
 	var apiUpdate hundApiV1.UpdateExpansionary
 
@@ -149,6 +178,7 @@ resource "hund_group" "test" {
 }
 
 resource "hund_component" "test" {
+	deletion_protection = false
 	group = hund_group.test.id
 	name = "Test Component"
 
@@ -191,6 +221,7 @@ func testAccIssueUpdateResourceConfig_archive(archive bool) string {
 	}
 
 	resource "hund_component" "test" {
+		deletion_protection = false
 		group = hund_group.test.id
 		name = "Test Component"
 
@@ -215,6 +246,7 @@ resource "hund_group" "test" {
 }
 
 resource "hund_component" "test" {
+	deletion_protection = false
 	group = hund_group.test.id
 	name = "Test Component"
 
@@ -253,6 +285,7 @@ func testAccIssueUpdateResourceConfig_template(overrideBody bool) string {
 	}
 
 	resource "hund_component" "test" {
+		deletion_protection = false
 		group = hund_group.test.id
 		name = "Test Component"
 
@@ -80,6 +80,40 @@ func (d *IssuesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 						"archive_on_destroy": schema.BoolAttribute{
 							Computed: true,
 						},
+						"adopt_external_updates": schema.BoolAttribute{
+							Computed: true,
+						},
+						"resolve_on_destroy": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"body": schema.StringAttribute{
+									Computed: true,
+								},
+								"body_translations": schema.MapAttribute{
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"template": schema.SingleNestedAttribute{
+									Computed: true,
+									Attributes: map[string]schema.Attribute{
+										"issue_template_id": schema.StringAttribute{
+											Computed: true,
+										},
+										"variables": schema.MapNestedAttribute{
+											Computed: true,
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"string":      schema.StringAttribute{Computed: true},
+													"number":      schema.NumberAttribute{Computed: true},
+													"i18n_string": schema.MapAttribute{Computed: true, ElementType: types.StringType},
+													"datetime":    schema.StringAttribute{Computed: true},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"created_at": schema.StringAttribute{
 							Computed: true,
 						},
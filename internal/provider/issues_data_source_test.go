@@ -113,6 +113,7 @@ func testAccIssuesDataSourceConfig_base(datum time.Time) string {
 		}
 
 		resource "hund_component" "test" {
+			deletion_protection = false
 			group = hund_group.test.id
 			name = "Test Component"
 
@@ -120,6 +121,7 @@ func testAccIssuesDataSourceConfig_base(datum time.Time) string {
 		}
 
 		resource "hund_component" "test1" {
+			deletion_protection = false
 			group = hund_group.test.id
 			name = "Test Component"
 
@@ -217,6 +219,7 @@ func testAccIssuesDataSourceConfig_resolved(datum time.Time) string {
 func testAccIssuesDataSourceConfig_components(datum time.Time) string {
 	return testAccIssuesDataSourceConfig_base(datum) + `
 		resource "hund_component" "test2" {
+			deletion_protection = false
 			group = hund_group.test.id
 			name = "Test Component"
 
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// listPageSize is the number of objects requested from the Hund API per page
+// when listing resources.
+const listPageSize = 100
+
+// listPageFunc retrieves the page of objects following startingAfter (or the
+// first page, when nil), reporting whether more pages remain.
+type listPageFunc[T any] func(startingAfter *string, diags *diag.Diagnostics) (page []T, hasMore bool)
+
+// listResultFunc fills in the result for a single listed object, returning
+// the ID of the object.
+type listResultFunc[T any] func(object T, result *list.ListResult) string
+
+// streamListResults pages through every object returned by retrievePage,
+// pushing a result for each until the request limit is reached.
+func streamListResults[T any](ctx context.Context, req list.ListRequest, retrievePage listPageFunc[T], setResult listResultFunc[T]) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var startingAfter *string
+		var count int64
+
+		for {
+			var diags diag.Diagnostics
+
+			page, hasMore := retrievePage(startingAfter, &diags)
+			if diags.HasError() {
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, object := range page {
+				result := req.NewListResult(ctx)
+
+				id := setResult(object, &result)
+				startingAfter = &id
+
+				if !push(result) {
+					return
+				}
+
+				count++
+
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+			}
+
+			if !hasMore || len(page) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// setListResult records the identity, and if requested, the resource state of
+// a listed object.
+func setListResult(ctx context.Context, req list.ListRequest, result *list.ListResult, id string, model any) {
+	result.Diagnostics.Append(setIdIdentity(ctx, result.Identity, types.StringValue(id))...)
+
+	if req.IncludeResource {
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	}
+}
+
+// configureListResourceClient returns the Hund API client handed to list
+// resources by the provider.
+func configureListResourceClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *hundApiV1.Client {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	client, ok := req.ProviderData.(*hundApiV1.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *hundApiV1.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil
+	}
+
+	return client
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestListResourceSchemas(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	for _, name := range []string{"hund_component", "hund_group", "hund_issue", "hund_issue_template", "hund_metric_provider"} {
+		if _, ok := resp.ListResourceSchemas[name]; !ok {
+			t.Errorf("expected a list resource schema for %s", name)
+		}
+	}
+}
+
+func TestStreamListResults(t *testing.T) {
+	ctx := context.Background()
+
+	r := NewGroupResource()
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	identityResp := resource.IdentitySchemaResponse{}
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	pages := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}
+
+	retrievePage := func(startingAfter *string, diags *diag.Diagnostics) ([]string, bool) {
+		index := 0
+
+		if startingAfter != nil {
+			for i, page := range pages {
+				if page[len(page)-1] == *startingAfter {
+					index = i + 1
+				}
+			}
+		}
+
+		return pages[index], index < len(pages)-1
+	}
+
+	setResult := func(id string, result *list.ListResult) string {
+		result.DisplayName = id
+		setListResult(ctx, list.ListRequest{}, result, id, nil)
+
+		return id
+	}
+
+	cases := []struct {
+		limit    int64
+		expected string
+	}{
+		{0, "[a b c d e]"},
+		{3, "[a b c]"},
+		{4, "[a b c d]"},
+	}
+
+	for _, c := range cases {
+		req := list.ListRequest{
+			Limit:                  c.limit,
+			ResourceSchema:         schemaResp.Schema,
+			ResourceIdentitySchema: identityResp.IdentitySchema,
+		}
+
+		names := []string{}
+
+		for result := range streamListResults(ctx, req, retrievePage, setResult) {
+			if result.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+			}
+
+			names = append(names, result.DisplayName)
+		}
+
+		if actual := fmt.Sprint(names); actual != c.expected {
+			t.Errorf("limit %d: expected %s, got %s", c.limit, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// redactedHeaders are the request headers redacted from logs.
+var redactedHeaders = []string{"Authorization", "Cookie"}
+
+// loggingTransport logs every request to the Hund API, and its response, at
+// TRACE. It wraps the retryable transport, so that each request is logged
+// once, with a latency which includes any retries.
+//
+// Requests are logged here, rather than from a RequestEditorFn, since the
+// per-request editors (such as hundApiV1.Expand) run after those of the
+// client, and so the final query is only known once the request is sent.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Trace(ctx, "sending Hund API request", map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"expand":  req.URL.Query()["expand[]"],
+		"headers": redactHeaders(req.Header),
+		"body":    hundApiV1.RedactBody(body),
+	})
+
+	start := time.Now()
+
+	rsp, err := t.next.RoundTrip(req)
+
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.Trace(ctx, "Hund API request failed", map[string]interface{}{
+			"method":     req.Method,
+			"path":       req.URL.Path,
+			"latency_ms": latency.Milliseconds(),
+			"error":      err.Error(),
+		})
+
+		return nil, err
+	}
+
+	rspBody, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp.Body = io.NopCloser(bytes.NewReader(rspBody))
+
+	tflog.Trace(ctx, "received Hund API response", map[string]interface{}{
+		"method":     req.Method,
+		"path":       req.URL.Path,
+		"status":     rsp.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+		"logref":     responseLogref(rsp, rspBody),
+		"body":       hundApiV1.RedactBody(rspBody),
+	})
+
+	return rsp, nil
+}
+
+// requestBody returns the body of the request, leaving it in place to be
+// sent.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		defer body.Close()
+
+		return io.ReadAll(body)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// responseLogref returns the ID the Hund API assigned to the request, from
+// the Logref header, or else from the logref of an error response.
+func responseLogref(rsp *http.Response, body []byte) string {
+	if logref := rsp.Header.Get("Logref"); logref != "" {
+		return logref
+	}
+
+	var errorBody struct {
+		Logref string `json:"logref"`
+	}
+
+	_ = json.Unmarshal(body, &errorBody)
+
+	return errorBody.Logref
+}
+
+// redactHeaders returns the given headers, with credentials redacted.
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+
+	for name := range header {
+		redacted[name] = header.Get(name)
+	}
+
+	for _, name := range redactedHeaders {
+		if header.Get(name) != "" {
+			redacted[name] = hundApiV1.RedactedValue
+		}
+	}
+
+	return redacted
+}
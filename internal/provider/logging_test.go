@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]string{
+		``:          ``,
+		`not json`:  `not json`,
+		`{"a":1.5}`: `{"a":1.5}`,
+		`{"service":{"api_key":"key","password":null}}`:                     `{"service":{"api_key":"***","password":null}}`,
+		`[{"secret_access_key":"key"},{"webhook_key":"key","name":"name"}]`: `[{"secret_access_key":"***"},{"name":"name","webhook_key":"***"}]`,
+	}
+
+	for body, expected := range cases {
+		if actual := hundApiV1.RedactBody([]byte(body)); actual != expected {
+			t.Errorf("RedactBody(%q): expected %q, got %q", body, expected, actual)
+		}
+	}
+
+	checkType := hundApiV1.PingdomWatchdogFormCreateCheckType("http")
+
+	forms := map[string]interface{}{
+		"pingdom": hundApiV1.PingdomWatchdogFormCreate{
+			ApiToken:  "pingdom-token",
+			CheckId:   "1234",
+			CheckType: &checkType,
+			Type:      "pingdom",
+		},
+		"uptimerobot": hundApiV1.UptimerobotWatchdogFormCreate{
+			MonitorApiKey: "uptimerobot-key",
+			Type:          "uptimerobot",
+		},
+		"updown": hundApiV1.UpdownFormCreate{
+			MonitorApiKey: "updown-key",
+			MonitorToken:  "updown-token",
+			Type:          hundApiV1.UpdownFormCreateTypeUpdown,
+		},
+	}
+
+	for name, form := range forms {
+		body, err := json.Marshal(hundApiV1.ComponentFormCreate{
+			Group:    "group",
+			Watchdog: hundApiV1.WatchdogFormCreate{Service: testWatchdogService(t, form)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		redacted := hundApiV1.RedactBody(body)
+
+		for _, secret := range []string{"pingdom-token", "uptimerobot-key", "updown-key", "updown-token"} {
+			if strings.Contains(redacted, secret) {
+				t.Errorf("%s: expected %q to be redacted, got %s", name, secret, redacted)
+			}
+		}
+
+		if !strings.Contains(redacted, `"type":"`+name+`"`) {
+			t.Errorf("%s: expected the rest of the form to be kept, got %s", name, redacted)
+		}
+	}
+}
+
+func testWatchdogService(t *testing.T, form interface{}) hundApiV1.FormWatchdogCreate {
+	t.Helper()
+
+	body, err := json.Marshal(form)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := hundApiV1.FormWatchdogCreate{}
+	if err := service.UnmarshalJSON(body); err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
+
+// TestSecretFieldsCoverSensitiveAttributes ensures that every attribute the
+// provider marks Sensitive is redacted from logs and fixtures, under the same
+// name in the Hund API.
+func TestSecretFieldsCoverSensitiveAttributes(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		metadataResp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "hund"}, &metadataResp)
+
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		for _, name := range sensitiveAttributes(schemaResp.Schema.Attributes) {
+			if !hundApiV1.IsSecretField(name) {
+				t.Errorf("%s: sensitive attribute %q is not redacted", metadataResp.TypeName, name)
+			}
+		}
+	}
+}
+
+// sensitiveAttributes returns the names of the Sensitive attributes, at any
+// depth.
+func sensitiveAttributes(attributes map[string]schema.Attribute) []string {
+	names := []string{}
+
+	for name, attribute := range attributes {
+		if attribute.IsSensitive() {
+			names = append(names, name)
+		}
+
+		switch attribute := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			names = append(names, sensitiveAttributes(attribute.Attributes)...)
+		case schema.ListNestedAttribute:
+			names = append(names, sensitiveAttributes(attribute.NestedObject.Attributes)...)
+		case schema.SetNestedAttribute:
+			names = append(names, sensitiveAttributes(attribute.NestedObject.Attributes)...)
+		case schema.MapNestedAttribute:
+			names = append(names, sensitiveAttributes(attribute.NestedObject.Attributes)...)
+		}
+	}
+
+	return names
+}
+
+func TestLoggingTransport(t *testing.T) {
+	server := hundtest.NewServer()
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client, err := newProviderClient("test", server.Endpoint(), "secret-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsp, err := client.RetrieveAGroup(ctx, "missing", hundApiV1.Expand("components"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = rsp.Body.Close()
+
+	if strings.Contains(output.String(), "secret-key") {
+		t.Errorf("expected the API key to be redacted, got %s", output.String())
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("expected a request and a response to be logged, got %v", entries)
+	}
+
+	request, response := entries[0], entries[1]
+
+	if request["@level"] != "trace" || request["method"] != "GET" || request["path"] != "/api/v1/groups/missing" {
+		t.Errorf("unexpected request entry: %v", request)
+	}
+
+	if expand, _ := request["expand"].([]interface{}); len(expand) != 1 || expand[0] != "components" {
+		t.Errorf("expected the request entry to include the expansions, got %v", request["expand"])
+	}
+
+	if headers, _ := request["headers"].(map[string]interface{}); headers["Authorization"] != hundApiV1.RedactedValue {
+		t.Errorf("expected the Authorization header to be redacted, got %v", request["headers"])
+	}
+
+	if response["status"] != float64(404) || response["logref"] != "404" {
+		t.Errorf("unexpected response entry: %v", response)
+	}
+
+	if _, ok := response["latency_ms"]; !ok {
+		t.Errorf("expected the response entry to include the latency, got %v", response)
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MaintenanceWindowFunction{}
+
+func NewMaintenanceWindowFunction() function.Function {
+	return &MaintenanceWindowFunction{}
+}
+
+// MaintenanceWindowFunction defines the function implementation.
+type MaintenanceWindowFunction struct{}
+
+var maintenanceWindowAttrTypes = map[string]attr.Type{
+	"starts_at":             types.StringType,
+	"ends_at":               types.StringType,
+	"notify_subscribers_at": types.StringType,
+}
+
+func (f *MaintenanceWindowFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "maintenance_window"
+}
+
+func (f *MaintenanceWindowFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the schedule of a maintenance window",
+		MarkdownDescription: "Returns an object suitable for the `schedule` attribute of a `hund_issue`, describing a maintenance window which begins at `start` and lasts for `duration`. When `notify_before` is given, subscribers are sent an `issue_upcoming` notification that long before the window begins.\n\nAll timestamps are returned in RFC3339 format, in UTC, exactly as the provider records them in state.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "start",
+				MarkdownDescription: "The RFC3339 timestamp at which the maintenance window begins.",
+			},
+			function.StringParameter{
+				Name:                "duration",
+				MarkdownDescription: "The length of the maintenance window, as a duration string such as `\"2h30m\"` (the same format accepted by `timeadd`). Must be a whole number of seconds, and at least one second, as timestamps are given to the second.",
+			},
+			function.StringParameter{
+				Name:                "notify_before",
+				MarkdownDescription: "How long before `start` to notify subscribers of the upcoming maintenance, as a duration string. Like `duration`, must be a whole number of seconds, and at least one second. When `null`, no `issue_upcoming` notification is scheduled.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: maintenanceWindowAttrTypes,
+		},
+	}
+}
+
+func (f *MaintenanceWindowFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var start, duration string
+	var notifyBefore types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &start, &duration, &notifyBefore))
+
+	if resp.Error != nil {
+		return
+	}
+
+	startsAt, err := hundApiV1.ToIntTimestamp(start)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid RFC3339 timestamp: "+err.Error())
+		return
+	}
+
+	length, err := time.ParseDuration(duration)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid duration: "+err.Error())
+		return
+	}
+
+	if int64(length.Seconds()) <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, "The duration of a maintenance window must be at least one second.")
+		return
+	}
+
+	if length%time.Second != 0 {
+		resp.Error = function.NewArgumentFuncError(1, "The duration of a maintenance window must be a whole number of seconds.")
+		return
+	}
+
+	notifySubscribersAt := types.StringNull()
+
+	if !notifyBefore.IsNull() {
+		before, err := time.ParseDuration(notifyBefore.ValueString())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(2, "Invalid duration: "+err.Error())
+			return
+		}
+
+		if int64(before.Seconds()) <= 0 {
+			resp.Error = function.NewArgumentFuncError(2, "The notification of a maintenance window must be sent at least one second before it begins.")
+			return
+		}
+
+		if before%time.Second != 0 {
+			resp.Error = function.NewArgumentFuncError(2, "The notification of a maintenance window must be sent a whole number of seconds before it begins.")
+			return
+		}
+
+		notifySubscribersAt = types.StringValue(hundApiV1.ToStringTimestamp(startsAt - int64(before.Seconds())))
+	}
+
+	schedule, diags := types.ObjectValue(maintenanceWindowAttrTypes, map[string]attr.Value{
+		"starts_at":             types.StringValue(hundApiV1.ToStringTimestamp(startsAt)),
+		"ends_at":               types.StringValue(hundApiV1.ToStringTimestamp(startsAt + int64(length.Seconds()))),
+		"notify_subscribers_at": notifySubscribersAt,
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, schedule)
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccMaintenanceWindowFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					locals {
+						schedule = provider::hund::maintenance_window("2024-06-01T04:00:00+02:00", "2h30m", "24h")
+					}
+
+					output "starts_at" {
+						value = local.schedule.starts_at
+					}
+
+					output "ends_at" {
+						value = local.schedule.ends_at
+					}
+
+					output "notify_subscribers_at" {
+						value = local.schedule.notify_subscribers_at
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("starts_at", "2024-06-01T02:00:00Z"),
+					resource.TestCheckOutput("ends_at", "2024-06-01T04:30:00Z"),
+					resource.TestCheckOutput("notify_subscribers_at", "2024-05-31T02:00:00Z"),
+				),
+			},
+			{
+				Config: providerConfig + `
+					output "test" {
+						value = provider::hund::maintenance_window("2024-06-01", "2h", null)
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid RFC3339 timestamp`),
+			},
+		},
+	})
+}
+
+func TestMaintenanceWindowFunctionRun(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		notifyBefore types.String
+		expected     map[string]attr.Value
+	}{
+		{
+			notifyBefore: types.StringValue("24h"),
+			expected: map[string]attr.Value{
+				"starts_at":             types.StringValue("2024-06-01T02:00:00Z"),
+				"ends_at":               types.StringValue("2024-06-01T04:30:00Z"),
+				"notify_subscribers_at": types.StringValue("2024-05-31T02:00:00Z"),
+			},
+		},
+		{
+			notifyBefore: types.StringNull(),
+			expected: map[string]attr.Value{
+				"starts_at":             types.StringValue("2024-06-01T02:00:00Z"),
+				"ends_at":               types.StringValue("2024-06-01T04:30:00Z"),
+				"notify_subscribers_at": types.StringNull(),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.ObjectUnknown(maintenanceWindowAttrTypes)),
+		}
+
+		NewMaintenanceWindowFunction().Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{
+				types.StringValue("2024-06-01T04:00:00+02:00"),
+				types.StringValue("2h30m"),
+				c.notifyBefore,
+			}),
+		}, &resp)
+
+		if resp.Error != nil {
+			t.Fatalf("unexpected error: %s", resp.Error)
+		}
+
+		expected := types.ObjectValueMust(maintenanceWindowAttrTypes, c.expected)
+
+		if !resp.Result.Value().Equal(expected) {
+			t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+		}
+	}
+
+	for _, args := range [][]attr.Value{
+		{types.StringValue("2024-06-01"), types.StringValue("2h"), types.StringNull()},
+		{types.StringValue("2024-06-01T04:00:00Z"), types.StringValue("-2h"), types.StringNull()},
+		{types.StringValue("2024-06-01T04:00:00Z"), types.StringValue("500ms"), types.StringNull()},
+		{types.StringValue("2024-06-01T04:00:00Z"), types.StringValue("1.5s"), types.StringNull()},
+		{types.StringValue("2024-06-01T04:00:00Z"), types.StringValue("2h"), types.StringValue("-1h")},
+		{types.StringValue("2024-06-01T04:00:00Z"), types.StringValue("2h"), types.StringValue("0s")},
+		{types.StringValue("2024-06-01T04:00:00Z"), types.StringValue("2h"), types.StringValue("500ms")},
+		{types.StringValue("2024-06-01T04:00:00Z"), types.StringValue("2h"), types.StringValue("90.5s")},
+	} {
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.ObjectUnknown(maintenanceWindowAttrTypes)),
+		}
+
+		NewMaintenanceWindowFunction().Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData(args),
+		}, &resp)
+
+		if resp.Error == nil {
+			t.Errorf("expected an error for arguments %v", args)
+		}
+	}
+}
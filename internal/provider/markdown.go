@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+
+	"github.com/hundio/terraform-provider-hund/internal/validators"
+)
+
+// markdownRenderer approximates the rendering of markdown fields by Hund:
+// GitHub Flavored Markdown, without raw HTML.
+var markdownRenderer = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+)
+
+// renderMarkdown renders the given markdown to HTML, as Hund would.
+func renderMarkdown(source string) (string, error) {
+	var buf bytes.Buffer
+
+	if err := markdownRenderer.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// predictMarkdownHtml fills in the planned HTML rendering of the markdown
+// attribute with the given name (e.g. "description_html" for "description"),
+// and of its translations, whenever the rendering is unknown but the markdown
+// is known.
+func predictMarkdownHtml(ctx context.Context, plan *tfsdk.Plan, name string, diags *diag.Diagnostics) {
+	if plan.Raw.IsNull() {
+		return
+	}
+
+	var source, html types.String
+	var sourceTranslations, htmlTranslations types.Map
+
+	diags.Append(plan.GetAttribute(ctx, path.Root(name), &source)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(name+"_html"), &html)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(name+"_translations"), &sourceTranslations)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(name+"_html_translations"), &htmlTranslations)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	// When only translations are given, the markdown is their original.
+	if source.IsUnknown() && !sourceTranslations.IsUnknown() && !sourceTranslations.IsNull() {
+		source = originalTranslation(sourceTranslations)
+	}
+
+	if html.IsUnknown() && !source.IsUnknown() {
+		predicted := types.StringNull()
+
+		if !source.IsNull() {
+			rendered, err := renderMarkdown(source.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root(name), "Unable to Render Markdown", err.Error())
+				return
+			}
+
+			predicted = types.StringValue(rendered)
+		}
+
+		diags.Append(plan.SetAttribute(ctx, path.Root(name+"_html"), predicted)...)
+	}
+
+	if htmlTranslations.IsUnknown() && !sourceTranslations.IsUnknown() && !sourceTranslations.IsNull() {
+		predicted := map[string]string{}
+
+		for locale, value := range sourceTranslations.Elements() {
+			text, ok := value.(types.String)
+			if !ok || text.IsUnknown() {
+				return
+			}
+
+			// The original locale is carried over as is.
+			if locale == validators.OriginalTranslationKey {
+				predicted[locale] = text.ValueString()
+				continue
+			}
+
+			rendered, err := renderMarkdown(text.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root(name+"_translations").AtMapKey(locale), "Unable to Render Markdown", err.Error())
+				return
+			}
+
+			predicted[locale] = rendered
+		}
+
+		predictedMap, diag := types.MapValueFrom(ctx, types.StringType, predicted)
+		diags.Append(diag...)
+		diags.Append(plan.SetAttribute(ctx, path.Root(name+"_html_translations"), predictedMap)...)
+	}
+}
+
+// originalTranslation returns the translation into the original locale of
+// the given translations, or unknown if there is none.
+func originalTranslation(translations types.Map) types.String {
+	elements := translations.Elements()
+
+	locale, ok := elements[validators.OriginalTranslationKey].(types.String)
+	if !ok || locale.IsUnknown() || locale.IsNull() {
+		return types.StringUnknown()
+	}
+
+	original, ok := elements[locale.ValueString()].(types.String)
+	if !ok {
+		return types.StringUnknown()
+	}
+
+	return original
+}
+
+// reconcileMarkdownHtml compares the HTML renderings predicted during plan
+// with those returned by the Hund API. As Terraform requires the applied
+// state to match the plan, the predictions are kept, and any difference is
+// reported as a warning; the next refresh records the Hund API's rendering.
+func reconcileMarkdownHtml(name string, planned types.String, actual *types.String, plannedTranslations types.Map, actualTranslations *types.Map, diags *diag.Diagnostics) {
+	differs := false
+
+	if !planned.IsUnknown() && !planned.Equal(*actual) {
+		*actual = planned
+		differs = true
+	}
+
+	if !plannedTranslations.IsUnknown() && !plannedTranslations.Equal(*actualTranslations) {
+		*actualTranslations = plannedTranslations
+		differs = true
+	}
+
+	if differs {
+		diags.Append(PredictedHtmlMismatchWarning(name))
+	}
+}
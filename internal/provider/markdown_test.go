@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	cases := map[string]string{
+		"**body**":             "<p><strong>body</strong></p>\n",
+		"*body*":               "<p><em>body</em></p>\n",
+		"~~gone~~":             "<p><del>gone</del></p>\n",
+		"<script>x</script>\n": "<!-- raw HTML omitted -->\n",
+	}
+
+	for source, expected := range cases {
+		actual, err := renderMarkdown(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual != expected {
+			t.Errorf("renderMarkdown(%q): expected %q, got %q", source, expected, actual)
+		}
+	}
+}
+
+func TestPredictMarkdownHtml(t *testing.T) {
+	ctx := context.Background()
+
+	r := NewGroupResource()
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	mapType := tftypes.Map{ElementType: tftypes.String}
+
+	unknownHtml := func(values map[string]tftypes.Value) tfsdk.Plan {
+		values["description_html"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		values["description_html_translations"] = tftypes.NewValue(mapType, tftypes.UnknownValue)
+
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: testObjectValue(objectType, values)}
+	}
+
+	cases := []struct {
+		name                 string
+		plan                 tfsdk.Plan
+		expectedHtml         types.String
+		expectedTranslations types.Map
+	}{
+		{
+			"description",
+			unknownHtml(map[string]tftypes.Value{
+				"description":              tftypes.NewValue(tftypes.String, "**bold**"),
+				"description_translations": tftypes.NewValue(mapType, tftypes.UnknownValue),
+			}),
+			types.StringValue("<p><strong>bold</strong></p>\n"),
+			types.MapUnknown(types.StringType),
+		},
+		{
+			"translations",
+			unknownHtml(map[string]tftypes.Value{
+				"description": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"description_translations": tftypes.NewValue(mapType, map[string]tftypes.Value{
+					"original": tftypes.NewValue(tftypes.String, "en"),
+					"en":       tftypes.NewValue(tftypes.String, "*on*"),
+					"de":       tftypes.NewValue(tftypes.String, "*an*"),
+				}),
+			}),
+			types.StringValue("<p><em>on</em></p>\n"),
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"original": types.StringValue("en"),
+				"en":       types.StringValue("<p><em>on</em></p>\n"),
+				"de":       types.StringValue("<p><em>an</em></p>\n"),
+			}),
+		},
+		{
+			"unknown description",
+			unknownHtml(map[string]tftypes.Value{
+				"description":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"description_translations": tftypes.NewValue(mapType, tftypes.UnknownValue),
+			}),
+			types.StringUnknown(),
+			types.MapUnknown(types.StringType),
+		},
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+
+		predictMarkdownHtml(ctx, &c.plan, "description", &diags)
+
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", c.name, diags)
+		}
+
+		var data GroupResourceModel
+		diags.Append(c.plan.Get(ctx, &data)...)
+
+		if !data.DescriptionHtml.Equal(c.expectedHtml) {
+			t.Errorf("%s: expected description_html %s, got %s", c.name, c.expectedHtml, data.DescriptionHtml)
+		}
+
+		if !data.DescriptionHtmlTranslations.Equal(c.expectedTranslations) {
+			t.Errorf("%s: expected description_html_translations %s, got %s", c.name, c.expectedTranslations, data.DescriptionHtmlTranslations)
+		}
+	}
+}
+
+func TestReconcileMarkdownHtml(t *testing.T) {
+	var diags diag.Diagnostics
+
+	actual := types.StringValue("<p>hund</p>")
+	actualTranslations := types.MapNull(types.StringType)
+
+	reconcileMarkdownHtml("body", types.StringValue("<p>hund</p>"), &actual, types.MapUnknown(types.StringType), &actualTranslations, &diags)
+
+	if len(diags) != 0 || !actualTranslations.IsNull() {
+		t.Errorf("expected matching predictions to be accepted silently, got %v", diags)
+	}
+
+	reconcileMarkdownHtml("body", types.StringValue("<p>predicted</p>"), &actual, types.MapUnknown(types.StringType), &actualTranslations, &diags)
+
+	if len(diags) != 1 || diags.HasError() || actual.ValueString() != "<p>predicted</p>" {
+		t.Errorf("expected the prediction to be kept with a warning, got %s and %v", actual, diags)
+	}
+
+	diags = diag.Diagnostics{}
+	actualTranslations = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"original": types.StringValue("en"),
+		"en":       types.StringValue("<div>predicted</div>"),
+	})
+	predictedTranslations := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"original": types.StringValue("en"),
+		"en":       types.StringValue("<p>predicted</p>"),
+	})
+
+	reconcileMarkdownHtml("body", types.StringValue("<p>predicted</p>"), &actual, predictedTranslations, &actualTranslations, &diags)
+
+	if len(diags) != 1 || diags.HasError() || !actualTranslations.Equal(predictedTranslations) {
+		t.Errorf("expected the predicted translations to be kept with a warning, got %s and %v", actualTranslations, diags)
+	}
+}
+
+func TestGroupResourceCreateRenderingMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	server := hundtest.NewServer()
+	server.RenderMarkdown = func(source string) string {
+		return "<div class=\"markdown\">" + source + "</div>"
+	}
+	defer server.Close()
+
+	client, err := newProviderClient("test", server.Endpoint(), "hundtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &GroupResource{client: client, renderMarkdownLocally: true}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	mapType := tftypes.Map{ElementType: tftypes.String}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: testObjectValue(objectType, map[string]tftypes.Value{
+		"name":                          tftypes.NewValue(tftypes.String, "Test Group"),
+		"description":                   tftypes.NewValue(tftypes.String, "**bold**"),
+		"description_translations":      tftypes.NewValue(mapType, tftypes.UnknownValue),
+		"description_html":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"description_html_translations": tftypes.NewValue(mapType, tftypes.UnknownValue),
+	})}
+
+	var diags diag.Diagnostics
+	predictMarkdownHtml(ctx, &plan, "description", &diags)
+
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	resp := resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	if warnings := resp.Diagnostics.Warnings(); len(warnings) != 1 || !warnings[0].Equal(PredictedHtmlMismatchWarning("description")) {
+		t.Errorf("expected a warning about the mismatched rendering, got %v", resp.Diagnostics)
+	}
+
+	var data GroupResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+	if data.DescriptionHtml.ValueString() != "<p><strong>bold</strong></p>\n" {
+		t.Errorf("expected the predicted rendering to be kept, got %s", data.DescriptionHtml)
+	}
+}
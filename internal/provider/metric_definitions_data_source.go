@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &MetricDefinitionsDataSource{}
+)
+
+func NewMetricDefinitionsDataSource() datasource.DataSource {
+	return &MetricDefinitionsDataSource{}
+}
+
+// MetricDefinitionsDataSource defines the data source implementation.
+type MetricDefinitionsDataSource struct{}
+
+// MetricDefinitionsDataSourceModel describes the data source data model.
+type MetricDefinitionsDataSourceModel struct {
+	Service types.String `tfsdk:"service"`
+
+	Services map[string]models.MetricDefinitionServiceModel `tfsdk:"services"`
+}
+
+func (d *MetricDefinitionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_definitions"
+}
+
+func (d *MetricDefinitionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	serviceTypes := []string{}
+	for serviceType := range models.MetricProviderServiceInstances() {
+		serviceTypes = append(serviceTypes, serviceType)
+	}
+	sort.Strings(serviceTypes)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The metric definitions expected by each MetricProvider service type. These are the keys that a `hund_metric_provider` must use in its `instances` map.",
+
+		Attributes: map[string]schema.Attribute{
+			"service": schema.StringAttribute{
+				MarkdownDescription: "When given, returns only the metric definitions of this service type (e.g. `http`, `builtin`, etc.).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(serviceTypes...),
+				},
+			},
+			"services": schema.MapNestedAttribute{
+				MarkdownDescription: "A Map of MetricProvider service types to the metric definitions they provide.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"definition_slugs": schema.ListAttribute{
+							MarkdownDescription: "The definition slugs of each MetricInstance provided by this service type.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"custom_instances": schema.BoolAttribute{
+							MarkdownDescription: "When true, this service type does not have a fixed set of metric definitions, and accepts arbitrary `instances` (e.g. `webhook`).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MetricDefinitionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetricDefinitionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Services = models.ToMetricDefinitionServiceModels()
+
+	if !data.Service.IsNull() {
+		service := data.Service.ValueString()
+
+		data.Services = map[string]models.MetricDefinitionServiceModel{
+			service: data.Services[service],
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
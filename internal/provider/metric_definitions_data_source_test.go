@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetricDefinitionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccMetricDefinitionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.hund_metric_definitions.test", "services.%", "10"),
+					resource.TestCheckResourceAttr("data.hund_metric_definitions.test", "services.builtin.definition_slugs.#", "2"),
+					resource.TestCheckResourceAttr("data.hund_metric_definitions.test", "services.builtin.definition_slugs.0", "percent_uptime"),
+					resource.TestCheckResourceAttr("data.hund_metric_definitions.test", "services.builtin.custom_instances", "false"),
+					resource.TestCheckResourceAttr("data.hund_metric_definitions.test", "services.webhook.definition_slugs.#", "0"),
+					resource.TestCheckResourceAttr("data.hund_metric_definitions.test", "services.webhook.custom_instances", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetricDefinitionsDataSource_service(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccMetricDefinitionsDataSourceConfig_service(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.hund_metric_definitions.test", "services.%", "1"),
+					resource.TestCheckResourceAttr("data.hund_metric_definitions.test", "services.udp.definition_slugs.#", "3"),
+					resource.TestCheckResourceAttr("hund_metric_provider.test", "instances.%", "3"),
+					resource.TestCheckResourceAttr("hund_metric_provider.test", "instances.udp.total_time.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricDefinitionsDataSourceConfig() string {
+	return providerConfig + `
+		data "hund_metric_definitions" "test" {
+		}
+	`
+}
+
+func testAccMetricDefinitionsDataSourceConfig_service() string {
+	return providerConfig + `
+		data "hund_metric_definitions" "test" {
+			service = "udp"
+		}
+
+		resource "hund_group" "test" {
+			name = "Test Group"
+		}
+
+		resource "hund_component" "test" {
+			deletion_protection = false
+			group = hund_group.test.id
+			name = "Test Component"
+
+			watchdog = {service = {manual = {}}}
+		}
+
+		resource "hund_metric_provider" "test" {
+			watchdog = hund_component.test.watchdog.id
+
+			instances = {
+				for slug in data.hund_metric_definitions.test.services["udp"].definition_slugs :
+				slug => { enabled = false }
+			}
+
+			service = {
+				udp = {
+					target = "example.com"
+					port = 53
+					send_data = "test"
+					regions = ["wa-us-1"]
+				}
+			}
+		}
+	`
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &MetricProviderListResource{}
+var _ list.ListResourceWithConfigure = &MetricProviderListResource{}
+
+func NewMetricProviderListResource() list.ListResource {
+	return &MetricProviderListResource{}
+}
+
+// MetricProviderListResource defines the list resource implementation.
+type MetricProviderListResource struct {
+	client *hundApiV1.Client
+}
+
+// MetricProviderListResourceModel describes the list resource data model.
+type MetricProviderListResourceModel struct {
+	Watchdog types.String `tfsdk:"watchdog"`
+	Default  types.Bool   `tfsdk:"default"`
+}
+
+func (r *MetricProviderListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_provider"
+}
+
+func (r *MetricProviderListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the MetricProviders of the status page, optionally filtered by the following arguments.",
+
+		Attributes: map[string]schema.Attribute{
+			"watchdog": schema.StringAttribute{
+				MarkdownDescription: "ObjectId for a particular Watchdog to retrieve MetricProviders on.",
+				Optional:            true,
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "When true, returns only MetricProviders for which `default` is true (i.e. returns MetricProviders that are considered the \"default\" for their respective Watchdogs). When used in conjunction with the `watchdog` parameter, returns the *single* default MetricProvider of that Watchdog, if it exists.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *MetricProviderListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListResourceClient(req, resp)
+}
+
+func (r *MetricProviderListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data MetricProviderListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	limit := listPageSize
+	params := hundApiV1.GetAllMetricProvidersParams{
+		Watchdog: data.Watchdog.ValueStringPointer(),
+		Default:  data.Default.ValueBoolPointer(),
+
+		Limit: &limit,
+	}
+
+	retrievePage := func(startingAfter *string, diags *diag.Diagnostics) ([]hundApiV1.MetricProvider, bool) {
+		params.StartingAfter = startingAfter
+
+		rsp, err := r.client.GetAllMetricProviders(ctx, &params)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Hund MetricProviders",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		metricProviders, err := hundApiV1.ParseGetAllMetricProvidersResponse(rsp)
+		if err != nil {
+			diags.AddError(
+				"Unable to Parse Hund MetricProviders",
+				err.Error(),
+			)
+			return nil, false
+		}
+
+		if metricProviders.StatusCode() != 200 {
+			diags.AddError(
+				"Failed response code from Hund API",
+				"Received a non-200 status code: "+fmt.Sprint(metricProviders.StatusCode())+
+					"\nError: "+string(metricProviders.Body),
+			)
+			return nil, false
+		}
+
+		return metricProviders.HALJSON200.Data, metricProviders.HALJSON200.HasMore
+	}
+
+	stream.Results = streamListResults(ctx, req, retrievePage, func(metricProvider hundApiV1.MetricProvider, result *list.ListResult) string {
+		result.DisplayName = metricProvider.Id
+
+		model, diag := models.ToMetricProviderModel(metricProvider)
+		result.Diagnostics.Append(diag...)
+
+		if result.Diagnostics.HasError() {
+			return metricProvider.Id
+		}
+
+		setListResult(ctx, req, result, metricProvider.Id, MetricProviderResourceModel(model))
+
+		return metricProvider.Id
+	})
+}
@@ -31,6 +31,7 @@ import (
 var _ resource.Resource = &MetricProviderResource{}
 var _ resource.ResourceWithConfigure = &MetricProviderResource{}
 var _ resource.ResourceWithImportState = &MetricProviderResource{}
+var _ resource.ResourceWithIdentity = &MetricProviderResource{}
 var _ resource.ResourceWithConfigValidators = &MetricProviderResource{}
 var _ resource.ResourceWithModifyPlan = &MetricProviderResource{}
 
@@ -40,7 +41,10 @@ func NewMetricProviderResource() resource.Resource {
 
 // MetricProviderResource defines the resource implementation.
 type MetricProviderResource struct {
-	client *hundApiV1.Client
+	client  *hundApiV1.Client
+	locales []string
+
+	readOnly bool
 }
 
 // MetricProviderResourceModel describes the resource data model.
@@ -134,6 +138,9 @@ func (r *MetricProviderResource) Schema(ctx context.Context, req resource.Schema
 							Optional:            true,
 							Computed:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.Map{
+								validators.TranslationKeys(),
+							},
 							PlanModifiers: []planmodifier.Map{
 								mapplanmodifier.UseStateForUnknown(),
 							},
@@ -154,6 +161,9 @@ func (r *MetricProviderResource) Schema(ctx context.Context, req resource.Schema
 							Optional:            true,
 							Computed:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.Map{
+								validators.TranslationKeys(),
+							},
 							PlanModifiers: []planmodifier.Map{
 								mapplanmodifier.UseStateForUnknown(),
 							},
@@ -174,6 +184,9 @@ func (r *MetricProviderResource) Schema(ctx context.Context, req resource.Schema
 							Optional:            true,
 							Computed:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.Map{
+								validators.TranslationKeys(),
+							},
 							PlanModifiers: []planmodifier.Map{
 								mapplanmodifier.UseStateForUnknown(),
 							},
@@ -340,6 +353,8 @@ func (r *MetricProviderResource) ConfigValidators(ctx context.Context) []resourc
 }
 
 func (r *MetricProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkTranslationLocales(ctx, r.locales, req.Config, &resp.Diagnostics)
+
 	if req.State.Raw.IsNull() {
 		var instances types.Map
 
@@ -411,27 +426,41 @@ func (r *MetricProviderResource) ModifyPlan(ctx context.Context, req resource.Mo
 	}
 }
 
+func (r *MetricProviderResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ObjectId of this MetricProvider.")
+}
+
 func (r *MetricProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*hundApiV1.Client)
+	data, ok := req.ProviderData.(*HundResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hundApiV1.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.locales = data.Locales
 }
 
 func (r *MetricProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_metric_provider", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_metric_provider"))
+		return
+	}
+
 	var data, config MetricProviderResourceModel
 
 	// Read Terraform plan data into the model
@@ -518,9 +547,13 @@ func (r *MetricProviderResource) Create(ctx context.Context, req resource.Create
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *MetricProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_metric_provider", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	var data MetricProviderResourceModel
 
 	// Read Terraform prior state data into the model
@@ -575,9 +608,18 @@ func (r *MetricProviderResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *MetricProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_metric_provider", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_metric_provider"))
+		return
+	}
+
 	var data, config, state MetricProviderResourceModel
 
 	// Read Terraform plan data into the model
@@ -713,9 +755,18 @@ func (r *MetricProviderResource) Update(ctx context.Context, req resource.Update
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setIdIdentity(ctx, resp.Identity, newState.Id)...)
 }
 
 func (r *MetricProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_metric_provider", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_metric_provider"))
+		return
+	}
+
 	var data MetricProviderResourceModel
 
 	// Read Terraform prior state data into the model
@@ -756,7 +807,7 @@ func (r *MetricProviderResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *MetricProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	watchdogId, viaWatchdog := strings.CutPrefix(req.ID, "default/")
+	watchdogId, viaWatchdog := strings.CutPrefix(importIdentityId(ctx, req, &resp.Diagnostics), "default/")
 
 	if viaWatchdog {
 		model := r.findDefaultMetricProvider(ctx, watchdogId, &resp.Diagnostics)
@@ -769,7 +820,7 @@ func (r *MetricProviderResource) ImportState(ctx context.Context, req resource.I
 		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *MetricProviderResource) findDefaultMetricProvider(ctx context.Context, watchdogId string, diags *diag.Diagnostics) *MetricProviderResourceModel {
@@ -199,6 +199,7 @@ resource "hund_group" "test" {
 }
 
 resource "hund_component" "test" {
+  deletion_protection = false
   name = "Test Component"
 
 	group = hund_group.test.id
@@ -279,6 +280,7 @@ resource "hund_group" "test" {
 }
 
 resource "hund_component" "test" {
+	deletion_protection = false
 	name = "Test Component"
 
 	group = hund_group.test.id
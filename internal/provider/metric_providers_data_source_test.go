@@ -66,6 +66,7 @@ func testAccMetricProvidersDataSourceConfig_base() string {
 		}
 
 		resource "hund_component" "test0" {
+			deletion_protection = false
 			group = hund_group.test.id
 			name = "Test Component"
 
@@ -73,6 +74,7 @@ func testAccMetricProvidersDataSourceConfig_base() string {
 		}
 
 		resource "hund_component" "test1" {
+			deletion_protection = false
 			group = hund_group.test.id
 			name = "Test Component"
 
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"sort"
+	"sync"
+)
+
+// MutexKV is a simple key/value store of mutexes, used to serialize API calls
+// which operate on the same remote object (e.g. the membership of a Group).
+type MutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func NewMutexKV() *MutexKV {
+	return &MutexKV{
+		store: map[string]*sync.Mutex{},
+	}
+}
+
+// Lock the mutex for the given key, creating it if necessary.
+func (m *MutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock the mutex for the given key.
+func (m *MutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+// LockAll locks the mutexes for each distinct key given, in a stable order so
+// that concurrent callers cannot deadlock. The returned function unlocks them.
+func (m *MutexKV) LockAll(keys ...string) func() {
+	distinct := map[string]bool{}
+	sorted := []string{}
+
+	for _, key := range keys {
+		if !distinct[key] {
+			distinct[key] = true
+			sorted = append(sorted, key)
+		}
+	}
+
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		m.Lock(key)
+	}
+
+	return func() {
+		for i := len(sorted) - 1; i >= 0; i-- {
+			m.Unlock(sorted[i])
+		}
+	}
+}
+
+func (m *MutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+
+	return mutex
+}
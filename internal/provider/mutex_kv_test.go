@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMutexKV(t *testing.T) {
+	mutex := NewMutexKV()
+
+	counter := 0
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			// Alternate key order to ensure LockAll cannot deadlock.
+			var unlock func()
+			if i%2 == 0 {
+				unlock = mutex.LockAll("a", "b", "a")
+			} else {
+				unlock = mutex.LockAll("b", "a")
+			}
+			defer unlock()
+
+			current := counter
+			counter = current + 1
+		}(i)
+	}
+
+	wg.Wait()
+
+	if counter != 50 {
+		t.Fatalf("expected counter to be 50, got %d", counter)
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &NativeRegionsDataSource{}
+)
+
+func NewNativeRegionsDataSource() datasource.DataSource {
+	return &NativeRegionsDataSource{}
+}
+
+// NativeRegionsDataSource defines the data source implementation.
+type NativeRegionsDataSource struct{}
+
+// NativeRegionsDataSourceModel describes the data source data model.
+type NativeRegionsDataSourceModel struct {
+	Regions []models.NativeRegionDetailModel `tfsdk:"regions"`
+}
+
+func (d *NativeRegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_native_regions"
+}
+
+func (d *NativeRegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The catalog of regions available to Hund Native Monitoring checks, as used in the `regions` attribute of native services.",
+
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "The identifier of this region, as accepted by the `regions` attribute of native services.",
+							Computed:            true,
+						},
+						"city": schema.StringAttribute{
+							MarkdownDescription: "The city (or metropolitan area) this region is located in.",
+							Computed:            true,
+						},
+						"country": schema.StringAttribute{
+							MarkdownDescription: "The ISO 3166-1 alpha-2 code of the country this region is located in.",
+							Computed:            true,
+						},
+						"continent": schema.StringAttribute{
+							MarkdownDescription: "The two-letter code of the continent this region is located in (one of `AS`, `EU`, `NA`, or `OC`).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NativeRegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NativeRegionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Regions = models.NativeRegionDetails()
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
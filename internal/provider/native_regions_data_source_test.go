@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNativeRegionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccNativeRegionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.hund_native_regions.test", "regions.#", "10"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.hund_native_regions.test", "regions.*", map[string]string{
+						"slug":      "fra-de-1",
+						"city":      "Frankfurt",
+						"country":   "DE",
+						"continent": "EU",
+					}),
+					resource.TestCheckOutput("eu_regions", "ams-nl-1,fra-de-1,hel-fi-1,lon-gb-1,par-fr-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNativeRegionsDataSourceConfig() string {
+	return providerConfig + `
+		data "hund_native_regions" "test" {
+		}
+
+		output "eu_regions" {
+			value = join(",", [for r in data.hund_native_regions.test.regions : r.slug if r.continent == "EU"])
+		}
+	`
+}
@@ -2,23 +2,31 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"strings"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/validators"
 )
 
 // Ensure HundProvider satisfies various provider interfaces.
 var _ provider.Provider = &HundProvider{}
+var _ provider.ProviderWithListResources = &HundProvider{}
+var _ provider.ProviderWithFunctions = &HundProvider{}
 
 // HundProvider defines the provider implementation.
 type HundProvider struct {
@@ -26,12 +34,44 @@ type HundProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// groupMutex serializes changes to the membership and ordering of the
+	// Components within each Group, keyed by Group ID.
+	groupMutex *MutexKV
+}
+
+// HundResourceData is handed to each resource when it is configured.
+type HundResourceData struct {
+	Client     *hundApiV1.Client
+	GroupMutex *MutexKV
+
+	// Locales are the locales of the status page, against which translations
+	// given in configuration are checked. Empty when not configured.
+	Locales []string
+
+	// RenderMarkdownLocally enables predicting the HTML rendering of markdown
+	// attributes during plan.
+	RenderMarkdownLocally bool
+
+	// ConflictDetection enables checking that objects were not modified
+	// outside Terraform before updating them.
+	ConflictDetection bool
+
+	// ReadOnly makes every change to the status page fail, before any request
+	// is made.
+	ReadOnly bool
 }
 
 // HundProviderModel describes the provider data model.
 type HundProviderModel struct {
-	Domain types.String `tfsdk:"domain"`
-	Key    types.String `tfsdk:"key"`
+	Domain   types.String `tfsdk:"domain"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	Key      types.String `tfsdk:"key"`
+	Locales  types.List   `tfsdk:"locales"`
+
+	RenderMarkdownLocally types.Bool `tfsdk:"render_markdown_locally"`
+	ConflictDetection     types.Bool `tfsdk:"conflict_detection"`
+	ReadOnly              types.Bool `tfsdk:"read_only"`
 }
 
 func (p *HundProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -43,15 +83,40 @@ func (p *HundProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The [Hund](https://hund.io) provider offers several resources and data sources to provision and query various objects on a Hund hosted status page.",
 		Attributes: map[string]schema.Attribute{
+			"conflict_detection": schema.BoolAttribute{
+				MarkdownDescription: "When true, `hund_component`, `hund_group`, and `hund_issue_template` are read again before being updated, and the update fails if the object was modified outside Terraform (i.e. its `updated_at` changed) since Terraform last read it, listing the changes, rather than overwriting them. Defaults to false.",
+				Optional:            true,
+			},
 			"domain": schema.StringAttribute{
 				MarkdownDescription: "The [domain](https://hund.io/help/api#section/Base-URL) at which to call the Hund API. Usually, this should be the domain of your status page.",
 				Optional:            true,
 			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the Hund API (e.g. `https://example.hund.io/api/v1`), overriding the one derived from `domain`. This is mainly useful for testing against a fake Hund API. May also be given by the `HUND_ENDPOINT` environment variable.",
+				Optional:            true,
+			},
 			"key": schema.StringAttribute{
 				MarkdownDescription: "The [Hund API key](https://hund.io/help/api#section/Authentication) used to authenticate with the API.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"locales": schema.ListAttribute{
+				MarkdownDescription: "The locales enabled on your status page (e.g. `[\"en\", \"de\"]`). When given, every `*_translations` attribute (and `i18n_string` template variable) is checked against these locales during plan: translations into any other locale are rejected, and missing translations are reported as warnings. The Hund API does not expose the locales of a status page, so they are not checked when this is not set; the format of each translation key is always validated.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(validators.LocaleTag()),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "When true, the provider never changes the status page: creating, updating, or deleting any resource fails before any request is made to the Hund API, while reading resources and data sources is unaffected. This is useful for planning with a read-only API key, e.g. in an audit pipeline. The Hund API offers no way to check the scope of an API key without attempting a change, so this must be set explicitly. Defaults to false.",
+				Optional:            true,
+			},
+			"render_markdown_locally": schema.BoolAttribute{
+				MarkdownDescription: "When true, the HTML renderings of markdown attributes (`description_html`, `body_html`, and their `_translations`) are predicted during plan by rendering the markdown locally, rather than being unknown until apply. Local rendering approximates Hund's renderer; when the Hund API renders differently, the prediction is kept for that apply with a warning, and Hund's rendering is recorded on the next refresh. Defaults to false.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -74,6 +139,15 @@ func (p *HundProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	if data.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown Hund API Endpoint",
+			"The provider cannot create the Hund API client as there is an unknown configuration value for the Hund API endpoint. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the HUND_ENDPOINT environment variable.",
+		)
+	}
+
 	if data.Key.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("key"),
@@ -88,17 +162,22 @@ func (p *HundProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	}
 
 	domain := os.Getenv("HUND_DOMAIN")
+	endpoint := os.Getenv("HUND_ENDPOINT")
 	key := os.Getenv("HUND_KEY")
 
 	if !data.Domain.IsNull() {
 		domain = data.Domain.ValueString()
 	}
 
+	if !data.Endpoint.IsNull() {
+		endpoint = data.Endpoint.ValueString()
+	}
+
 	if !data.Key.IsNull() {
 		key = data.Key.ValueString()
 	}
 
-	if domain == "" {
+	if domain == "" && endpoint == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("domain"),
 			"Missing Hund API Domain",
@@ -122,7 +201,11 @@ func (p *HundProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	client, err := newProviderClient(p.version, domain, key)
+	if endpoint == "" {
+		endpoint = domainEndpoint(domain)
+	}
+
+	client, err := newProviderClient(p.version, endpoint, key)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Hund API Client",
@@ -133,19 +216,41 @@ func (p *HundProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	if data.ReadOnly.ValueBool() {
+		client.RequestEditors = append(client.RequestEditors, rejectWrites)
+	}
+
+	// Unknown locales are not checked, as if they were not configured.
+	locales := []string{}
+
+	if !data.Locales.IsUnknown() {
+		resp.Diagnostics.Append(data.Locales.ElementsAs(ctx, &locales, false)...)
+	}
+
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ListResourceData = client
+	resp.ResourceData = &HundResourceData{
+		Client:     client,
+		GroupMutex: p.groupMutex,
+		Locales:    locales,
+
+		RenderMarkdownLocally: data.RenderMarkdownLocally.ValueBool(),
+		ConflictDetection:     data.ConflictDetection.ValueBool(),
+		ReadOnly:              data.ReadOnly.ValueBool(),
+	}
 }
 
 func (p *HundProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGroupResource,
 		NewGroupComponentOrderingResource,
+		NewGroupOrderingResource,
 		NewComponentResource,
 		NewMetricProviderResource,
 		NewIssueResource,
 		NewIssueUpdateResource,
 		NewIssueTemplateResource,
+		NewRecurringMaintenanceResource,
 	}
 }
 
@@ -156,29 +261,63 @@ func (p *HundProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewMetricProvidersDataSource,
 		NewIssuesDataSource,
 		NewIssueTemplatesDataSource,
+		NewNativeRegionsDataSource,
+		NewMetricDefinitionsDataSource,
+	}
+}
+
+func (p *HundProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewGroupListResource,
+		NewComponentListResource,
+		NewMetricProviderListResource,
+		NewIssueListResource,
+		NewIssueTemplateListResource,
+	}
+}
+
+func (p *HundProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewMaintenanceWindowFunction,
+		NewUnixToRFC3339Function,
 	}
 }
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &HundProvider{
-			version: version,
+			version:    version,
+			groupMutex: NewMutexKV(),
 		}
 	}
 }
 
-func newProviderClient(version string, domain string, key string) (*hundApiV1.Client, error) {
-	security, err := hundApiV1.WithSecurity(key)
-	if err != nil {
-		return nil, err
+// transport, when set, replaces the HTTP transport of every Hund API client
+// created by the provider. Tests use it to record and replay interactions
+// with the Hund API.
+var transport http.RoundTripper
+
+// domainEndpoint returns the base URL of the Hund API served at the given
+// domain.
+func domainEndpoint(domain string) string {
+	if strings.HasSuffix(domain, ".localhost") {
+		return "http://" + domain + ":3000/api/v1"
 	}
 
-	var endpoint string
+	return "https://" + domain + "/api/v1"
+}
+
+func newProviderClient(version string, endpoint string, key string) (*hundApiV1.Client, error) {
+	return newTransportClient(version, endpoint, key, transport)
+}
 
-	if strings.HasSuffix(domain, ".localhost") {
-		endpoint = "http://" + domain + ":3000/api/v1"
-	} else {
-		endpoint = "https://" + domain + "/api/v1"
+// newTransportClient returns a Hund API client like newProviderClient, which
+// sends its requests through the given transport, if any, rather than the
+// default.
+func newTransportClient(version string, endpoint string, key string, next http.RoundTripper) (*hundApiV1.Client, error) {
+	security, err := hundApiV1.WithSecurity(key)
+	if err != nil {
+		return nil, err
 	}
 
 	options := func(client *hundApiV1.Client) error {
@@ -192,11 +331,30 @@ func newProviderClient(version string, domain string, key string) (*hundApiV1.Cl
 		})
 
 		retryableClient := retryablehttp.NewClient()
+		retryableClient.RequestLogHook = recordResend
+
+		if next != nil {
+			retryableClient.HTTPClient.Transport = next
+		}
+
+		standardClient := retryableClient.StandardClient()
+		standardClient.Transport = &tracingTransport{next: &loggingTransport{next: standardClient.Transport}}
 
-		client.Client = retryableClient.StandardClient()
+		client.Client = standardClient
 
 		return nil
 	}
 
 	return hundApiV1.NewClient(endpoint, security, options)
 }
+
+// rejectWrites is a RequestEditorFn which refuses every request that could
+// change the status page, guarding read_only mode against any change which
+// is not already refused by its resource.
+func rejectWrites(ctx context.Context, req *http.Request) error {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return nil
+	}
+
+	return fmt.Errorf("refusing to send %s %s, as the provider is configured with read_only = true", req.Method, req.URL.Path)
+}
@@ -1,11 +1,24 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
 )
 
 const (
@@ -27,12 +40,172 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"hund": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testFixtureEndpoint is the endpoint at which fixtures are replayed. Nothing
+// is served there; the recorder intercepts every request.
+const testFixtureEndpoint = "https://fixtures.hund.invalid/api/v1"
+
+// testRecorder records or replays the interactions of each acceptance test
+// with the Hund API, when HUND_FIXTURES is set.
+var testRecorder *hundtest.Recorder
+
+// testFakeServer is the in-process fake of the Hund API which acceptance
+// tests run against, when no status page is given.
+var testFakeServer *hundtest.Server
+
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	if testRecorder != nil {
+		testAccFixture(t)
+	}
+}
+
+// testAccFixture loads the fixture of the test into testRecorder.
+func testAccFixture(t *testing.T) {
+	testLoadFixture(t, testRecorder)
+}
+
+// testLoadFixture loads the fixture of the test into the recorder. When
+// replaying, a test without a recorded fixture fails, unless
+// HUND_FIXTURES_SKIP_MISSING is set, in which case it is skipped. Recorded
+// fixtures are saved once the test passes.
+func testLoadFixture(t *testing.T, recorder *hundtest.Recorder) {
+	path := testFixturePath(t)
+
+	if err := recorder.Load(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			if os.Getenv("HUND_FIXTURES_SKIP_MISSING") != "" {
+				t.Skipf("no fixture recorded at %s", path)
+			}
+
+			t.Fatalf("no fixture recorded at %s: record it with HUND_FIXTURES=record, or set HUND_FIXTURES_SKIP_MISSING=1 to skip tests without fixtures", path)
+		}
+
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if t.Failed() {
+			return
+		}
+
+		if err := recorder.Save(); err != nil {
+			t.Errorf("saving fixture %s: %s", path, err)
+		}
+	})
+}
+
+// testFixturePath returns the path of the fixture of the test.
+func testFixturePath(t *testing.T) string {
+	return filepath.Join("testdata", "fixtures", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// testFixtureClient returns a Hund API client whose interactions are
+// recorded into the fixture of the test, with HUND_FIXTURES=record, or else
+// replayed from it. Unlike acceptance tests, tests using it replay their
+// fixtures by default, so that every run of the unit tests covers the
+// recorded shapes of Hund API responses. Until a fixture has been recorded
+// against a real status page, the test is skipped, unless HUND_FIXTURES is
+// set.
+func testFixtureClient(t *testing.T) *hundApiV1.Client {
+	recorder := testRecorder
+	if recorder == nil {
+		if _, err := os.Stat(testFixturePath(t)); errors.Is(err, fs.ErrNotExist) {
+			t.Skipf("no fixture recorded against a real status page at %s", testFixturePath(t))
+		}
+
+		recorder = hundtest.NewRecorder(hundtest.ModeReplay)
+	}
+
+	if recorder.Mode == hundtest.ModeRecord && testFakeServer != nil {
+		t.Skip("fixtures are only recorded against a real status page: set HUND_DOMAIN or HUND_ENDPOINT")
+	}
+
+	testLoadFixture(t, recorder)
+
+	endpoint, key := testFixtureEndpoint, "replay"
+
+	if recorder.Mode == hundtest.ModeRecord {
+		endpoint = os.Getenv("HUND_ENDPOINT")
+		if endpoint == "" {
+			endpoint = domainEndpoint(os.Getenv("HUND_DOMAIN"))
+		}
+
+		key = os.Getenv("HUND_KEY")
+	}
+
+	client, err := newTransportClient("test", endpoint, key, recorder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// testObjectValue returns a value of the given object type, whose attributes
+// are those given, or else null.
+func testObjectValue(objectType tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attributes := map[string]tftypes.Value{}
+
+	for name, typ := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(typ, nil)
+	}
+
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	return tftypes.NewValue(objectType, attributes)
 }
 
 func testToTfTimestamp(t time.Time) string {
 	return t.UTC().Format(time.RFC3339)
 }
+
+func TestReadOnly(t *testing.T) {
+	ctx := context.Background()
+
+	server := hundtest.NewServer()
+	defer server.Close()
+
+	p := New("test")()
+
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	configureResp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: testObjectValue(objectType, map[string]tftypes.Value{
+			"endpoint":  tftypes.NewValue(tftypes.String, server.Endpoint()),
+			"key":       tftypes.NewValue(tftypes.String, "hundtest"),
+			"read_only": tftypes.NewValue(tftypes.Bool, true),
+		})},
+	}, &configureResp)
+
+	if configureResp.Diagnostics.HasError() {
+		t.Fatal(configureResp.Diagnostics)
+	}
+
+	data := configureResp.ResourceData.(*HundResourceData)
+
+	if !data.ReadOnly {
+		t.Error("expected resources to be configured as read-only")
+	}
+
+	if _, err := data.Client.GetAllGroups(ctx, nil); err != nil {
+		t.Errorf("expected reads to be allowed, got %s", err)
+	}
+
+	if _, err := data.Client.CreateAGroup(ctx, hundApiV1.GroupFormCreate{}); err == nil || !strings.Contains(err.Error(), "read_only") {
+		t.Errorf("expected writes to be refused before any request, got %v", err)
+	}
+
+	r := &GroupResource{readOnly: true}
+
+	createResp := resource.CreateResponse{}
+	r.Create(ctx, resource.CreateRequest{}, &createResp)
+
+	if errs := createResp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != "Provider Is Read-Only" {
+		t.Errorf("expected Create to fail as read-only, got %v", createResp.Diagnostics)
+	}
+}
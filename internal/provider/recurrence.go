@@ -0,0 +1,517 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recurrence computes the start times of a recurring event.
+type recurrence interface {
+	// next returns the first occurrence strictly after t, or false if the
+	// recurrence has no further occurrences.
+	next(t time.Time) (time.Time, bool)
+}
+
+// occurrencesAfter returns (up to) the first n occurrences of r after t.
+func occurrencesAfter(r recurrence, t time.Time, n int) []time.Time {
+	result := []time.Time{}
+
+	for len(result) < n {
+		occurrence, ok := r.next(t)
+		if !ok {
+			break
+		}
+
+		result = append(result, occurrence)
+		t = occurrence
+	}
+
+	return result
+}
+
+// recurrenceSearchLimit bounds the search for the next occurrence of a
+// recurrence which (almost) never matches, such as "0 0 31 2 *".
+const recurrenceSearchLimit = 5 * 366 * 24 * time.Hour
+
+// cronRecurrence is a standard five-field cron expression: minute, hour, day
+// of month, month, and day of week.
+type cronRecurrence struct {
+	minutes, hours, days, months, weekdays []bool
+
+	// restrictedDays and restrictedWeekdays record whether the day of month and
+	// day of week fields were restricted (not "*"). When both are, a day
+	// matches if either field matches, as in Vixie cron.
+	restrictedDays, restrictedWeekdays bool
+
+	location *time.Location
+}
+
+var cronMonthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var cronWeekdayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// parseCron parses a five-field cron expression, evaluated in the given
+// location.
+func parseCron(expr string, location *time.Location) (recurrence, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute, hour, day of month, month, day of week), got %d", len(fields))
+	}
+
+	r := cronRecurrence{location: location}
+
+	var err error
+
+	if r.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+
+	if r.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+
+	if r.days, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+
+	if r.months, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+
+	// Day of week accepts 7 as an alias of Sunday.
+	weekdays, err := parseCronField(fields[4], 0, 7, cronWeekdayNames)
+	if err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+
+	r.weekdays = weekdays[:7]
+	r.weekdays[0] = weekdays[0] || weekdays[7]
+
+	r.restrictedDays = !strings.HasPrefix(fields[2], "*")
+	r.restrictedWeekdays = !strings.HasPrefix(fields[4], "*")
+
+	return r, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges (a-b), and
+// steps (*/n or a-b/n), returning a slice indexed by value.
+func parseCronField(field string, min int, max int, names map[string]int) ([]bool, error) {
+	result := make([]bool, max+1)
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+
+		low, high := min, max
+
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+
+			if low, err = parseCronValue(lowPart, min, max, names); err != nil {
+				return nil, err
+			}
+
+			high = low
+
+			if isRange {
+				if high, err = parseCronValue(highPart, min, max, names); err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				high = max
+			}
+
+			if high < low {
+				return nil, fmt.Errorf("invalid range %q", rangePart)
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			result[v] = true
+		}
+	}
+
+	return result, nil
+}
+
+func parseCronValue(value string, min int, max int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || v < min || v > max {
+		return 0, fmt.Errorf("invalid value %q, expected %d-%d", value, min, max)
+	}
+
+	return v, nil
+}
+
+func (r cronRecurrence) matchesDay(t time.Time) bool {
+	day := r.days[t.Day()]
+	weekday := r.weekdays[t.Weekday()]
+
+	if r.restrictedDays && r.restrictedWeekdays {
+		return day || weekday
+	}
+
+	return day && weekday
+}
+
+func (r cronRecurrence) next(t time.Time) (time.Time, bool) {
+	t = t.In(r.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(recurrenceSearchLimit)
+
+	for t.Before(limit) {
+		switch {
+		case !r.months[t.Month()]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, r.location)
+		case !r.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, r.location)
+		case !r.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, r.location)
+		case !r.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// rruleRecurrence is a subset of the RFC 5545 RRULE: DAILY, WEEKLY, and
+// MONTHLY frequencies, with INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY,
+// BYHOUR, BYMINUTE, and WKST.
+type rruleRecurrence struct {
+	dtstart time.Time
+
+	frequency string
+	interval  int
+	count     int
+	until     *time.Time
+
+	byDay      []rruleWeekday
+	byMonthDay []int
+	byHour     []int
+	byMinute   []int
+	weekStart  time.Weekday
+}
+
+type rruleWeekday struct {
+	// ordinal selects the nth (or from the end, when negative) such weekday
+	// of a month. Zero selects every such weekday.
+	ordinal int
+	weekday time.Weekday
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// parseRRule parses an RRULE (with or without its "RRULE:" prefix), whose
+// first occurrence is dtstart. Occurrences are computed in the location of
+// dtstart.
+func parseRRule(rule string, dtstart time.Time) (recurrence, error) {
+	r := rruleRecurrence{
+		dtstart:   dtstart,
+		interval:  1,
+		weekStart: time.Monday,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		var err error
+
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.frequency = strings.ToUpper(value)
+
+			if !slices.Contains([]string{"DAILY", "WEEKLY", "MONTHLY"}, r.frequency) {
+				return nil, fmt.Errorf("unsupported FREQ %q, expected DAILY, WEEKLY, or MONTHLY", value)
+			}
+		case "INTERVAL":
+			if r.interval, err = strconv.Atoi(value); err != nil || r.interval < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", value)
+			}
+		case "COUNT":
+			if r.count, err = strconv.Atoi(value); err != nil || r.count < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q", value)
+			}
+		case "UNTIL":
+			until, err := parseRRuleUntil(value, dtstart.Location())
+			if err != nil {
+				return nil, err
+			}
+
+			r.until = &until
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				day = strings.ToUpper(day)
+
+				if len(day) < 2 {
+					return nil, fmt.Errorf("invalid BYDAY %q", day)
+				}
+
+				weekday, ok := rruleWeekdays[day[len(day)-2:]]
+				if !ok {
+					return nil, fmt.Errorf("invalid BYDAY %q", day)
+				}
+
+				ordinal := 0
+				if len(day) > 2 {
+					if ordinal, err = strconv.Atoi(day[:len(day)-2]); err != nil || ordinal == 0 || ordinal < -5 || ordinal > 5 {
+						return nil, fmt.Errorf("invalid BYDAY %q", day)
+					}
+				}
+
+				r.byDay = append(r.byDay, rruleWeekday{ordinal: ordinal, weekday: weekday})
+			}
+		case "BYMONTHDAY":
+			if r.byMonthDay, err = parseRRuleInts(value, -31, 31, true); err != nil {
+				return nil, fmt.Errorf("invalid BYMONTHDAY: %w", err)
+			}
+		case "BYHOUR":
+			if r.byHour, err = parseRRuleInts(value, 0, 23, false); err != nil {
+				return nil, fmt.Errorf("invalid BYHOUR: %w", err)
+			}
+		case "BYMINUTE":
+			if r.byMinute, err = parseRRuleInts(value, 0, 59, false); err != nil {
+				return nil, fmt.Errorf("invalid BYMINUTE: %w", err)
+			}
+		case "WKST":
+			weekStart, ok := rruleWeekdays[strings.ToUpper(value)]
+			if !ok {
+				return nil, fmt.Errorf("invalid WKST %q", value)
+			}
+
+			r.weekStart = weekStart
+		default:
+			return nil, fmt.Errorf("unsupported rule part %q", name)
+		}
+	}
+
+	if r.frequency == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+
+	if r.count > 0 && r.until != nil {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot both be given")
+	}
+
+	for _, day := range r.byDay {
+		if day.ordinal != 0 && r.frequency != "MONTHLY" {
+			return nil, fmt.Errorf("BYDAY ordinals are only supported with FREQ=MONTHLY")
+		}
+	}
+
+	if len(r.byHour) == 0 {
+		r.byHour = []int{dtstart.Hour()}
+	}
+
+	if len(r.byMinute) == 0 {
+		r.byMinute = []int{dtstart.Minute()}
+	}
+
+	return r, nil
+}
+
+func parseRRuleUntil(value string, location *time.Location) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		loc := location
+		if strings.HasSuffix(layout, "Z") {
+			loc = time.UTC
+		}
+
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			if layout == "20060102" {
+				t = t.Add(24*time.Hour - time.Second)
+			}
+
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", value)
+}
+
+func parseRRuleInts(value string, min int, max int, nonZero bool) ([]int, error) {
+	result := []int{}
+
+	for _, part := range strings.Split(value, ",") {
+		v, err := strconv.Atoi(part)
+		if err != nil || v < min || v > max || (nonZero && v == 0) {
+			return nil, fmt.Errorf("invalid value %q", part)
+		}
+
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
+func (r rruleRecurrence) next(t time.Time) (time.Time, bool) {
+	location := r.dtstart.Location()
+	count := 0
+
+	// Periods are enumerated from the one containing dtstart, so that
+	// INTERVAL and COUNT are counted from the first occurrence.
+	for period := 0; ; period += r.interval {
+		start := r.periodStart(period)
+
+		if start.Sub(t) > recurrenceSearchLimit || (r.until != nil && start.After(*r.until)) {
+			return time.Time{}, false
+		}
+
+		for _, day := range r.periodDays(start) {
+			for _, hour := range sortedInts(r.byHour) {
+				for _, minute := range sortedInts(r.byMinute) {
+					occurrence := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, r.dtstart.Second(), 0, location)
+
+					if occurrence.Before(r.dtstart) {
+						continue
+					}
+
+					if r.until != nil && occurrence.After(*r.until) {
+						return time.Time{}, false
+					}
+
+					count++
+
+					if r.count > 0 && count > r.count {
+						return time.Time{}, false
+					}
+
+					if occurrence.After(t) {
+						return occurrence, true
+					}
+				}
+			}
+		}
+	}
+}
+
+// periodStart returns the first day of the nth period following the period
+// containing dtstart.
+func (r rruleRecurrence) periodStart(n int) time.Time {
+	d := r.dtstart
+	location := d.Location()
+
+	switch r.frequency {
+	case "WEEKLY":
+		offset := (int(d.Weekday()) - int(r.weekStart) + 7) % 7
+		return time.Date(d.Year(), d.Month(), d.Day()-offset+7*n, 0, 0, 0, 0, location)
+	case "MONTHLY":
+		return time.Date(d.Year(), d.Month()+time.Month(n), 1, 0, 0, 0, 0, location)
+	default:
+		return time.Date(d.Year(), d.Month(), d.Day()+n, 0, 0, 0, 0, location)
+	}
+}
+
+// periodDays returns the days of the period beginning at start on which the
+// rule occurs, in order.
+func (r rruleRecurrence) periodDays(start time.Time) []time.Time {
+	location := start.Location()
+	candidates := []time.Time{}
+
+	switch r.frequency {
+	case "WEEKLY":
+		for i := 0; i < 7; i++ {
+			candidates = append(candidates, time.Date(start.Year(), start.Month(), start.Day()+i, 0, 0, 0, 0, location))
+		}
+	case "MONTHLY":
+		for day := start; day.Month() == start.Month(); day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, location) {
+			candidates = append(candidates, day)
+		}
+	default:
+		candidates = append(candidates, start)
+	}
+
+	result := []time.Time{}
+
+	for _, day := range candidates {
+		if r.matchesDay(day) {
+			result = append(result, day)
+		}
+	}
+
+	return result
+}
+
+func (r rruleRecurrence) matchesDay(day time.Time) bool {
+	if len(r.byMonthDay) > 0 {
+		daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+
+		if !slices.ContainsFunc(r.byMonthDay, func(d int) bool {
+			return d == day.Day() || (d < 0 && daysInMonth+d+1 == day.Day())
+		}) {
+			return false
+		}
+	}
+
+	if len(r.byDay) > 0 {
+		return slices.ContainsFunc(r.byDay, func(d rruleWeekday) bool {
+			return d.matches(day)
+		})
+	}
+
+	// Without BYDAY or BYMONTHDAY, the rule recurs on the weekday (WEEKLY) or
+	// day of month (MONTHLY) of dtstart.
+	if len(r.byMonthDay) == 0 {
+		switch r.frequency {
+		case "WEEKLY":
+			return day.Weekday() == r.dtstart.Weekday()
+		case "MONTHLY":
+			return day.Day() == r.dtstart.Day()
+		}
+	}
+
+	return true
+}
+
+func (d rruleWeekday) matches(day time.Time) bool {
+	if day.Weekday() != d.weekday {
+		return false
+	}
+
+	switch {
+	case d.ordinal > 0:
+		return (day.Day()-1)/7+1 == d.ordinal
+	case d.ordinal < 0:
+		daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+		return (daysInMonth-day.Day())/7+1 == -d.ordinal
+	}
+
+	return true
+}
+
+func sortedInts(values []int) []int {
+	result := slices.Clone(values)
+	slices.Sort(result)
+
+	return result
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func testRecurrenceOccurrences(t *testing.T, r recurrence, err error, from time.Time, n int) []string {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := []string{}
+
+	for _, occurrence := range occurrencesAfter(r, from, n) {
+		result = append(result, occurrence.Format(time.RFC3339))
+	}
+
+	return result
+}
+
+func testRecurrenceCompare(t *testing.T, name string, actual []string, expected []string) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Errorf("%s: expected %v, got %v", name, expected, actual)
+		return
+	}
+
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("%s: expected %v, got %v", name, expected, actual)
+			return
+		}
+	}
+}
+
+func TestParseCron(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+
+	from := time.Date(2025, time.March, 28, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		expr     string
+		location *time.Location
+		expected []string
+	}{
+		{"0 2 * * TUE", time.UTC, []string{"2025-04-01T02:00:00Z", "2025-04-08T02:00:00Z"}},
+		{"30 22 1,15 * *", time.UTC, []string{"2025-04-01T22:30:00Z", "2025-04-15T22:30:00Z"}},
+		{"*/20 12 * * *", time.UTC, []string{"2025-03-28T12:20:00Z", "2025-03-28T12:40:00Z", "2025-03-29T12:00:00Z"}},
+		{"0 0 29 2 *", time.UTC, []string{"2028-02-29T00:00:00Z"}},
+		// Both day fields restricted: either matches.
+		{"0 0 13 * 5", time.UTC, []string{"2025-04-04T00:00:00Z", "2025-04-11T00:00:00Z", "2025-04-13T00:00:00Z"}},
+		// Crosses the start of daylight saving time on 2025-03-30.
+		{"0 3 * * 7", berlin, []string{"2025-03-30T03:00:00+02:00", "2025-04-06T03:00:00+02:00"}},
+	}
+
+	for _, c := range cases {
+		r, err := parseCron(c.expr, c.location)
+		actual := testRecurrenceOccurrences(t, r, err, from, len(c.expected))
+
+		testRecurrenceCompare(t, c.expr, actual, c.expected)
+	}
+
+	for _, expr := range []string{"0 2 * *", "60 * * * *", "0 0 0 * *", "0 0 * * 8", "5-1 * * * *", "*/0 * * * *"} {
+		if _, err := parseCron(expr, time.UTC); err == nil {
+			t.Errorf("%s: expected an error", expr)
+		}
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	dtstart := time.Date(2025, time.January, 7, 2, 0, 0, 0, time.UTC)
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		rule     string
+		from     time.Time
+		expected []string
+	}{
+		{"FREQ=WEEKLY", from, []string{"2025-01-07T02:00:00Z", "2025-01-14T02:00:00Z"}},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH", from, []string{"2025-01-07T02:00:00Z", "2025-01-09T02:00:00Z", "2025-01-21T02:00:00Z"}},
+		{"FREQ=DAILY;COUNT=2", from, []string{"2025-01-07T02:00:00Z", "2025-01-08T02:00:00Z"}},
+		{"FREQ=DAILY;UNTIL=20250108", from, []string{"2025-01-07T02:00:00Z", "2025-01-08T02:00:00Z"}},
+		{"FREQ=DAILY;BYHOUR=2,14;BYMINUTE=30", from, []string{"2025-01-07T02:30:00Z", "2025-01-07T14:30:00Z", "2025-01-08T02:30:00Z"}},
+		{"FREQ=MONTHLY", from, []string{"2025-01-07T02:00:00Z", "2025-02-07T02:00:00Z"}},
+		{"FREQ=MONTHLY;BYDAY=1SU", from, []string{"2025-02-02T02:00:00Z", "2025-03-02T02:00:00Z"}},
+		{"FREQ=MONTHLY;BYDAY=-1FR", from, []string{"2025-01-31T02:00:00Z", "2025-02-28T02:00:00Z"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", from, []string{"2025-01-31T02:00:00Z", "2025-02-28T02:00:00Z"}},
+		// dtstart is only a lower bound when it does not match the rule.
+		{"FREQ=WEEKLY;BYDAY=WE", from, []string{"2025-01-08T02:00:00Z", "2025-01-15T02:00:00Z"}},
+		// COUNT includes occurrences before the search starts.
+		{"FREQ=WEEKLY;COUNT=3", time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC), []string{"2025-01-21T02:00:00Z"}},
+	}
+
+	for _, c := range cases {
+		r, err := parseRRule(c.rule, dtstart)
+		actual := testRecurrenceOccurrences(t, r, err, c.from, len(c.expected)+1)
+
+		testRecurrenceCompare(t, c.rule, actual[:min(len(actual), len(c.expected))], c.expected)
+	}
+
+	for _, rule := range []string{"INTERVAL=2", "FREQ=YEARLY", "FREQ=WEEKLY;BYDAY=1MO", "FREQ=DAILY;COUNT=2;UNTIL=20250108", "FREQ=DAILY;BYSETPOS=1", "FREQ=MONTHLY;BYMONTHDAY=0"} {
+		if _, err := parseRRule(rule, dtstart); err == nil {
+			t.Errorf("%s: expected an error", rule)
+		}
+	}
+}
@@ -0,0 +1,849 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+	"github.com/hundio/terraform-provider-hund/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecurringMaintenanceResource{}
+var _ resource.ResourceWithConfigure = &RecurringMaintenanceResource{}
+var _ resource.ResourceWithConfigValidators = &RecurringMaintenanceResource{}
+var _ resource.ResourceWithValidateConfig = &RecurringMaintenanceResource{}
+var _ resource.ResourceWithModifyPlan = &RecurringMaintenanceResource{}
+
+// defaultRecurringMaintenanceHorizon is the number of upcoming occurrences
+// kept scheduled, when no horizon is given.
+const defaultRecurringMaintenanceHorizon = 4
+
+func NewRecurringMaintenanceResource() resource.Resource {
+	return &RecurringMaintenanceResource{}
+}
+
+// RecurringMaintenanceResource defines the resource implementation.
+type RecurringMaintenanceResource struct {
+	client  *hundApiV1.Client
+	locales []string
+
+	readOnly bool
+}
+
+// RecurringMaintenanceResourceModel describes the resource data model.
+type RecurringMaintenanceResourceModel struct {
+	Id           types.String                       `tfsdk:"id"`
+	Cron         types.String                       `tfsdk:"cron"`
+	RRule        types.String                       `tfsdk:"rrule"`
+	StartsAt     types.String                       `tfsdk:"starts_at"`
+	TimeZone     types.String                       `tfsdk:"time_zone"`
+	Duration     types.String                       `tfsdk:"duration"`
+	NotifyBefore types.String                       `tfsdk:"notify_before"`
+	Horizon      types.Int64                        `tfsdk:"horizon"`
+	ComponentIds []types.String                     `tfsdk:"component_ids"`
+	Template     *RecurringMaintenanceTemplateModel `tfsdk:"template"`
+	Issues       types.List                         `tfsdk:"issues"`
+}
+
+type RecurringMaintenanceTemplateModel struct {
+	IssueTemplateId types.String                                  `tfsdk:"issue_template_id"`
+	Variables       models.IssueTemplateVariablesApplicationModel `tfsdk:"variables"`
+}
+
+// RecurringMaintenanceIssueModel describes a single scheduled occurrence.
+type RecurringMaintenanceIssueModel struct {
+	Id                  types.String `tfsdk:"id"`
+	StartsAt            types.String `tfsdk:"starts_at"`
+	EndsAt              types.String `tfsdk:"ends_at"`
+	NotifySubscribersAt types.String `tfsdk:"notify_subscribers_at"`
+}
+
+var recurringMaintenanceIssueAttrTypes = map[string]attr.Type{
+	"id":                    types.StringType,
+	"starts_at":             types.StringType,
+	"ends_at":               types.StringType,
+	"notify_subscribers_at": types.StringType,
+}
+
+func (r *RecurringMaintenanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recurring_maintenance"
+}
+
+func (r *RecurringMaintenanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The RecurringMaintenance resource keeps a rolling window of scheduled maintenance Issues on your status page, following a recurrence rule. On each apply, the next `horizon` occurrences are scheduled as Issues using the given IssueTemplate, and scheduled Issues which no longer match the rule are cancelled. Occurrences which have already started are never modified.\n\nThis resource has no counterpart in the Hund API: it only manages the Issues listed in `issues`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A randomly generated identifier for this recurring maintenance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cron": schema.StringAttribute{
+				MarkdownDescription: "A five-field cron expression (minute, hour, day of month, month, day of week) giving the start time of each occurrence, evaluated in `time_zone`.",
+				Optional:            true,
+			},
+			"rrule": schema.StringAttribute{
+				MarkdownDescription: "An iCalendar (RFC 5545) recurrence rule giving the start time of each occurrence, such as `FREQ=WEEKLY;BYDAY=TU;BYHOUR=2;BYMINUTE=0`, counted from `starts_at`. The first occurrence is the first time at or after `starts_at` which matches the rule, so `starts_at` is itself an occurrence only when it matches `BYDAY`, `BYMONTHDAY`, `BYHOUR` and `BYMINUTE`. `FREQ` may be `DAILY`, `WEEKLY`, or `MONTHLY`; `INTERVAL`, `COUNT`, `UNTIL`, `BYDAY`, `BYMONTHDAY`, `BYHOUR`, `BYMINUTE`, and `WKST` are supported.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("starts_at")),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "An RFC3339 timestamp before which no occurrence is scheduled. When using `rrule`, periods (for `INTERVAL`) and `COUNT` are counted from `starts_at`, and its time of day is used when `BYHOUR` or `BYMINUTE` is not given.",
+				Optional:            true,
+			},
+			"time_zone": schema.StringAttribute{
+				MarkdownDescription: "The IANA time zone in which the recurrence is evaluated, such as `Europe/Berlin`. Defaults to `UTC`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("UTC"),
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "The duration of each occurrence, as a Go duration string (e.g. `2h` or `90m`).",
+				Required:            true,
+			},
+			"notify_before": schema.StringAttribute{
+				MarkdownDescription: "How long before each occurrence subscribers are notified of the upcoming maintenance, as a Go duration string (e.g. `24h`). When not given, no `issue_upcoming` notification is sent.",
+				Optional:            true,
+			},
+			"horizon": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of upcoming occurrences kept scheduled. Defaults to `%d`.", defaultRecurringMaintenanceHorizon),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultRecurringMaintenanceHorizon),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"component_ids": schema.SetAttribute{
+				MarkdownDescription: "The Components IDs affected by each occurrence.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"template": schema.SingleNestedAttribute{
+				MarkdownDescription: "The IssueTemplate (of `kind = \"issue\"`) applied to each occurrence.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"issue_template_id": schema.StringAttribute{
+						MarkdownDescription: "The ObjectId of the IssueTemplate to apply.",
+						Required:            true,
+					},
+					"variables": issueTemplateApplicationSchema().Attributes["variables"],
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"issues": schema.ListNestedAttribute{
+				MarkdownDescription: "The scheduled Issues currently managed by this resource, ordered by `starts_at`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: idFieldMarkdownDescription("Issue"),
+							Computed:            true,
+						},
+						"starts_at": schema.StringAttribute{
+							MarkdownDescription: "The time at which this occurrence begins.",
+							Computed:            true,
+						},
+						"ends_at": schema.StringAttribute{
+							MarkdownDescription: "The time at which this occurrence ends.",
+							Computed:            true,
+						},
+						"notify_subscribers_at": schema.StringAttribute{
+							MarkdownDescription: "The time at which subscribers are notified of this occurrence. This field is `null` if no notification is sent.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *RecurringMaintenanceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("cron"),
+			path.MatchRoot("rrule"),
+		),
+	}
+}
+
+func (r *RecurringMaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RecurringMaintenanceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range []struct {
+		name  string
+		value types.String
+	}{
+		{"duration", data.Duration},
+		{"notify_before", data.NotifyBefore},
+	} {
+		if p.value.IsNull() || p.value.IsUnknown() {
+			continue
+		}
+
+		if d, err := time.ParseDuration(p.value.ValueString()); err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(p.name),
+				"Invalid Duration",
+				fmt.Sprintf("Expected a positive duration such as \"2h\" or \"90m\", got: %q", p.value.ValueString()),
+			)
+		}
+	}
+
+	if !data.StartsAt.IsNull() && !data.StartsAt.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.StartsAt.ValueString()); err != nil {
+			resp.Diagnostics.Append(models.TimestampError(err))
+		}
+	}
+
+	if data.Cron.IsUnknown() || data.RRule.IsUnknown() || data.StartsAt.IsUnknown() || data.TimeZone.IsUnknown() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.recurrence(&resp.Diagnostics)
+}
+
+func (r *RecurringMaintenanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkTranslationLocales(ctx, r.locales, req.Config, &resp.Diagnostics)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RecurringMaintenanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() || !plan.known() {
+		return
+	}
+
+	var state RecurringMaintenanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	current := state.issues(ctx, &resp.Diagnostics)
+	desired := plan.desiredIssues(time.Now(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, cancel, create := reconcileRecurringMaintenance(current, desired, time.Now())
+
+	if len(cancel) == 0 && len(create) == 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("issues"), state.Issues)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("issues"), types.ListUnknown(types.ObjectType{AttrTypes: recurringMaintenanceIssueAttrTypes}))...)
+	resp.Diagnostics.Append(RecurringMaintenanceDriftWarning(cancel, create))
+}
+
+func (r *RecurringMaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*HundResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HundResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.locales = data.Locales
+}
+
+func (r *RecurringMaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_recurring_maintenance", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("create", "hund_recurring_maintenance"))
+		return
+	}
+
+	var data RecurringMaintenanceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Generate Recurring Maintenance ID",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(id)
+
+	r.applyRecurringMaintenance(ctx, &data, nil, &resp.Diagnostics)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state, even on error, so that any Issues
+	// already scheduled are tracked.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurringMaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_recurring_maintenance", "Read")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	var data RecurringMaintenanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := data.issues(ctx, &resp.Diagnostics)
+	refreshed := []RecurringMaintenanceIssueModel{}
+
+	for _, occurrence := range current {
+		issue, found := r.retrieveIssue(ctx, occurrence.Id.ValueString(), &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Occurrences which were deleted, cancelled, or have ended are no
+		// longer managed.
+		if !found || issue.CancelledAt != nil || issue.Schedule == nil || issue.Schedule.Ended {
+			continue
+		}
+
+		refreshed = append(refreshed, toRecurringMaintenanceIssueModel(*issue))
+	}
+
+	data.setIssues(ctx, refreshed, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurringMaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_recurring_maintenance", "Update")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("update", "hund_recurring_maintenance"))
+		return
+	}
+
+	var data, state RecurringMaintenanceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The occurrences only change when drift was reported during plan.
+	if data.Issues.IsUnknown() {
+		r.applyRecurringMaintenance(ctx, &data, state.issues(ctx, &resp.Diagnostics), &resp.Diagnostics)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurringMaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "hund_recurring_maintenance", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
+	if r.readOnly {
+		resp.Diagnostics.Append(ReadOnlyError("delete", "hund_recurring_maintenance"))
+		return
+	}
+
+	var data RecurringMaintenanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+
+	for _, occurrence := range data.issues(ctx, &resp.Diagnostics) {
+		if !occurrence.started(now) {
+			r.cancelIssue(ctx, occurrence.Id.ValueString(), &resp.Diagnostics)
+		}
+	}
+}
+
+// applyRecurringMaintenance cancels the current occurrences which no longer
+// match the recurrence, and schedules the missing ones, recording the result
+// in data.
+func (r *RecurringMaintenanceResource) applyRecurringMaintenance(ctx context.Context, data *RecurringMaintenanceResourceModel, current []RecurringMaintenanceIssueModel, diags *diag.Diagnostics) {
+	now := time.Now()
+
+	desired := data.desiredIssues(now, diags)
+
+	if diags.HasError() {
+		data.setIssues(ctx, current, diags)
+		return
+	}
+
+	keep, cancel, create := reconcileRecurringMaintenance(current, desired, now)
+
+	for _, occurrence := range cancel {
+		if r.cancelIssue(ctx, occurrence.Id.ValueString(), diags); diags.HasError() {
+			// Keep tracking the occurrences which could not be cancelled.
+			keep = append(keep, occurrence)
+		}
+	}
+
+	for _, occurrence := range create {
+		if diags.HasError() {
+			break
+		}
+
+		issue := r.createIssue(ctx, *data, occurrence, diags)
+
+		if issue != nil {
+			keep = append(keep, toRecurringMaintenanceIssueModel(*issue))
+		}
+	}
+
+	data.setIssues(ctx, keep, diags)
+}
+
+func (r *RecurringMaintenanceResource) createIssue(ctx context.Context, data RecurringMaintenanceResourceModel, occurrence RecurringMaintenanceIssueModel, diags *diag.Diagnostics) *hundApiV1.Issue {
+	starts, err := hundApiV1.ToIntTimestamp(occurrence.StartsAt.ValueString())
+	if err != nil {
+		diags.Append(models.TimestampError(err))
+		return nil
+	}
+
+	ends, err := hundApiV1.ToIntTimestamp(occurrence.EndsAt.ValueString())
+	if err != nil {
+		diags.Append(models.TimestampError(err))
+		return nil
+	}
+
+	notify, err := hundApiV1.ToIntTimestampPtr(occurrence.NotifySubscribersAt.ValueStringPointer())
+	if err != nil {
+		diags.Append(models.TimestampError(err))
+		return nil
+	}
+
+	templateForm := hundApiV1.IssueTemplateApplicationIssueFormCreate{}
+
+	prepareIssueTemplateApplication(ctx, models.IssueTemplateApplicationIssueModel{
+		IssueTemplateId:   data.Template.IssueTemplateId,
+		Title:             types.StringUnknown(),
+		TitleTranslations: types.MapUnknown(types.StringType),
+		Body:              types.StringUnknown(),
+		BodyTranslations:  types.MapUnknown(types.StringType),
+		Label:             types.StringUnknown(),
+		Schema:            types.MapUnknown(types.ObjectType{}),
+		Variables:         data.Template.Variables,
+	}, &templateForm, diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	opaqueForm := hundApiV1.IssueFormCreate_Template{}
+	err = opaqueForm.FromIssueFormCreateTemplate1(templateForm)
+	if err != nil {
+		diags.AddError(
+			"Template conversion error",
+			"Got error encoding IssueTemplateApplication: "+err.Error(),
+		)
+		return nil
+	}
+
+	form := hundApiV1.IssueFormCreate{
+		Components: hundApiV1.ToStringList(data.ComponentIds),
+		Schedule: &hundApiV1.ScheduleFormCreate{
+			StartsAt:            starts,
+			EndsAt:              ends,
+			NotifySubscribersAt: notify,
+		},
+		Template: &opaqueForm,
+	}
+
+	rsp, err := r.client.CreateAIssue(ctx, form)
+	if err != nil {
+		diags.AddError(
+			"Unable to Create Hund Issue",
+			err.Error(),
+		)
+		return nil
+	}
+
+	issue, err := hundApiV1.ParseCreateAIssueResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Issue",
+			err.Error(),
+		)
+		return nil
+	}
+
+	if issue.StatusCode() != 201 {
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-201 status code: "+fmt.Sprint(issue.StatusCode())+
+				"\nError: "+string(issue.Body),
+		)
+		return nil
+	}
+
+	tflog.Debug(ctx, "scheduled recurring maintenance occurrence", map[string]interface{}{
+		"issue":     issue.HALJSON201.Id,
+		"starts_at": occurrence.StartsAt.ValueString(),
+	})
+
+	return issue.HALJSON201
+}
+
+func (r *RecurringMaintenanceResource) cancelIssue(ctx context.Context, issueId string, diags *diag.Diagnostics) {
+	rsp, err := r.client.CancelAScheduledIssue(ctx, issueId, hundApiV1.IssueFormCancel{})
+	if err != nil {
+		diags.AddError(
+			"Unable to Cancel Hund Issue",
+			err.Error(),
+		)
+		return
+	}
+
+	if rsp.StatusCode == 404 {
+		return
+	}
+
+	issue, err := hundApiV1.ParseCancelAScheduledIssueResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Issue",
+			err.Error(),
+		)
+		return
+	}
+
+	if issue.StatusCode() != 200 {
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-200 status code: "+fmt.Sprint(issue.StatusCode())+
+				"\nError: "+string(issue.Body),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "cancelled recurring maintenance occurrence", map[string]interface{}{
+		"issue": issueId,
+	})
+}
+
+// retrieveIssue returns the Issue with the given ID, reporting false if it no
+// longer exists.
+func (r *RecurringMaintenanceResource) retrieveIssue(ctx context.Context, issueId string, diags *diag.Diagnostics) (*hundApiV1.Issue, bool) {
+	rsp, err := r.client.RetrieveAIssue(ctx, issueId)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Hund Issue",
+			err.Error(),
+		)
+		return nil, false
+	}
+
+	issue, err := hundApiV1.ParseRetrieveAIssueResponse(rsp)
+	if err != nil {
+		diags.AddError(
+			"Unable to Parse Hund Issue",
+			err.Error(),
+		)
+		return nil, false
+	}
+
+	if issue.StatusCode() == 404 {
+		return nil, false
+	}
+
+	if issue.StatusCode() != 200 {
+		diags.AddError(
+			"Failed response code from Hund API",
+			"Received a non-200 status code: "+fmt.Sprint(issue.StatusCode())+
+				"\nError: "+string(issue.Body),
+		)
+		return nil, false
+	}
+
+	return issue.HALJSON200, true
+}
+
+func toRecurringMaintenanceIssueModel(issue hundApiV1.Issue) RecurringMaintenanceIssueModel {
+	model := RecurringMaintenanceIssueModel{
+		Id:                  types.StringValue(issue.Id),
+		StartsAt:            types.StringNull(),
+		EndsAt:              types.StringNull(),
+		NotifySubscribersAt: types.StringNull(),
+	}
+
+	if issue.Schedule != nil {
+		model.StartsAt = types.StringValue(hundApiV1.ToStringTimestamp(issue.Schedule.StartsAt))
+		model.EndsAt = types.StringValue(hundApiV1.ToStringTimestamp(issue.Schedule.EndsAt))
+		model.NotifySubscribersAt = types.StringPointerValue(hundApiV1.ToStringTimestampPtr(issue.Schedule.NotifySubscribersAt))
+	}
+
+	return model
+}
+
+// known reports whether every argument determining the occurrences is known.
+func (m RecurringMaintenanceResourceModel) known() bool {
+	for _, value := range []attr.Value{m.Cron, m.RRule, m.StartsAt, m.TimeZone, m.Duration, m.NotifyBefore, m.Horizon} {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// recurrence parses the cron expression or RRULE of this recurring
+// maintenance.
+func (m RecurringMaintenanceResourceModel) recurrence(diags *diag.Diagnostics) recurrence {
+	location, err := time.LoadLocation(m.TimeZone.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("time_zone"),
+			"Invalid Time Zone",
+			"Expected an IANA time zone name: "+err.Error(),
+		)
+		return nil
+	}
+
+	if !m.Cron.IsNull() {
+		rec, err := parseCron(m.Cron.ValueString(), location)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("cron"),
+				"Invalid Cron Expression",
+				err.Error(),
+			)
+		}
+
+		return rec
+	}
+
+	dtstart, err := time.Parse(time.RFC3339, m.StartsAt.ValueString())
+	if err != nil {
+		diags.Append(models.TimestampError(err))
+		return nil
+	}
+
+	rec, err := parseRRule(m.RRule.ValueString(), dtstart.In(location))
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("rrule"),
+			"Invalid Recurrence Rule",
+			err.Error(),
+		)
+	}
+
+	return rec
+}
+
+// desiredIssues returns the next horizon occurrences starting after now,
+// without IDs.
+func (m RecurringMaintenanceResourceModel) desiredIssues(now time.Time, diags *diag.Diagnostics) []RecurringMaintenanceIssueModel {
+	rec := m.recurrence(diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	duration, err := time.ParseDuration(m.Duration.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("duration"), "Invalid Duration", err.Error())
+		return nil
+	}
+
+	var notifyBefore *time.Duration
+
+	if !m.NotifyBefore.IsNull() {
+		d, err := time.ParseDuration(m.NotifyBefore.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("notify_before"), "Invalid Duration", err.Error())
+			return nil
+		}
+
+		notifyBefore = &d
+	}
+
+	from := now
+
+	if !m.StartsAt.IsNull() {
+		startsAt, err := time.Parse(time.RFC3339, m.StartsAt.ValueString())
+		if err != nil {
+			diags.Append(models.TimestampError(err))
+			return nil
+		}
+
+		// Occurrences may start exactly at starts_at.
+		if startsAt.After(from) {
+			from = startsAt.Add(-time.Nanosecond)
+		}
+	}
+
+	result := []RecurringMaintenanceIssueModel{}
+
+	for _, start := range occurrencesAfter(rec, from, int(m.Horizon.ValueInt64())) {
+		occurrence := RecurringMaintenanceIssueModel{
+			Id:                  types.StringNull(),
+			StartsAt:            types.StringValue(hundApiV1.ToStringTimestamp(start.Unix())),
+			EndsAt:              types.StringValue(hundApiV1.ToStringTimestamp(start.Add(duration).Unix())),
+			NotifySubscribersAt: types.StringNull(),
+		}
+
+		if notifyBefore != nil {
+			occurrence.NotifySubscribersAt = types.StringValue(hundApiV1.ToStringTimestamp(start.Add(-*notifyBefore).Unix()))
+		}
+
+		result = append(result, occurrence)
+	}
+
+	return result
+}
+
+func (m RecurringMaintenanceResourceModel) issues(ctx context.Context, diags *diag.Diagnostics) []RecurringMaintenanceIssueModel {
+	result := []RecurringMaintenanceIssueModel{}
+
+	if m.Issues.IsNull() || m.Issues.IsUnknown() {
+		return result
+	}
+
+	diags.Append(m.Issues.ElementsAs(ctx, &result, false)...)
+
+	return result
+}
+
+func (m *RecurringMaintenanceResourceModel) setIssues(ctx context.Context, issues []RecurringMaintenanceIssueModel, diags *diag.Diagnostics) {
+	slices.SortFunc(issues, func(a, b RecurringMaintenanceIssueModel) int {
+		return strings.Compare(a.StartsAt.ValueString(), b.StartsAt.ValueString())
+	})
+
+	list, diag := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: recurringMaintenanceIssueAttrTypes}, issues)
+	diags.Append(diag...)
+
+	m.Issues = list
+}
+
+// started reports whether the occurrence has begun as of now.
+func (m RecurringMaintenanceIssueModel) started(now time.Time) bool {
+	startsAt, err := time.Parse(time.RFC3339, m.StartsAt.ValueString())
+
+	return err == nil && !startsAt.After(now)
+}
+
+// matches reports whether both occurrences are scheduled identically.
+func (m RecurringMaintenanceIssueModel) matches(other RecurringMaintenanceIssueModel) bool {
+	return m.StartsAt.Equal(other.StartsAt) &&
+		m.EndsAt.Equal(other.EndsAt) &&
+		m.NotifySubscribersAt.Equal(other.NotifySubscribersAt)
+}
+
+// reconcileRecurringMaintenance compares the currently scheduled occurrences
+// with the desired ones. Current occurrences which have started are always
+// kept, as are those which are still desired; the rest are cancelled. Desired
+// occurrences which are not yet scheduled are created.
+func reconcileRecurringMaintenance(current []RecurringMaintenanceIssueModel, desired []RecurringMaintenanceIssueModel, now time.Time) (keep []RecurringMaintenanceIssueModel, cancel []RecurringMaintenanceIssueModel, create []RecurringMaintenanceIssueModel) {
+	keep = []RecurringMaintenanceIssueModel{}
+	cancel = []RecurringMaintenanceIssueModel{}
+	create = []RecurringMaintenanceIssueModel{}
+
+	matched := make([]bool, len(desired))
+
+	for _, occurrence := range current {
+		index := slices.IndexFunc(desired, occurrence.matches)
+
+		switch {
+		case index >= 0 && !matched[index]:
+			matched[index] = true
+			keep = append(keep, occurrence)
+		case occurrence.started(now):
+			keep = append(keep, occurrence)
+		default:
+			cancel = append(cancel, occurrence)
+		}
+	}
+
+	for i, occurrence := range desired {
+		if !matched[i] {
+			create = append(create, occurrence)
+		}
+	}
+
+	return keep, cancel, create
+}
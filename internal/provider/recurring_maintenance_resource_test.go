@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRecurringMaintenanceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRecurringMaintenanceResourceConfig(2, "2h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_recurring_maintenance.test", "issues.#", "2"),
+					resource.TestCheckResourceAttrSet("hund_recurring_maintenance.test", "issues.0.id"),
+					resource.TestCheckResourceAttrSet("hund_recurring_maintenance.test", "issues.0.notify_subscribers_at"),
+				),
+			},
+			// Update the horizon and duration, rescheduling every occurrence
+			{
+				Config: testAccRecurringMaintenanceResourceConfig(3, "1h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hund_recurring_maintenance.test", "issues.#", "3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecurringMaintenanceResourceConfig(horizon int, duration string) string {
+	return providerConfig + fmt.Sprintf(`
+	resource "hund_group" "test" {
+		name = "Test Group"
+	}
+
+	resource "hund_component" "test" {
+		deletion_protection = false
+		group = hund_group.test.id
+		name = "Test Component"
+
+		watchdog = {service = {manual = {}}}
+	}
+
+	resource "hund_issue_template" "test" {
+		kind = "issue"
+		name = "Test Maintenance Template"
+
+		title = "Scheduled maintenance"
+		body = "{{vars.summary}}"
+
+		variables = {
+			summary = { required = true }
+		}
+	}
+
+	resource "hund_recurring_maintenance" "test" {
+		rrule = "FREQ=WEEKLY;BYDAY=TU"
+		starts_at = %[1]q
+
+		duration = %[2]q
+		notify_before = "24h"
+		horizon = %[3]d
+
+		component_ids = [hund_component.test.id]
+
+		template = {
+			issue_template_id = hund_issue_template.test.id
+
+			variables = {
+				summary = { string = "Weekly database maintenance." }
+			}
+		}
+	}
+	`, testToTfTimestamp(time.Now().Add(24*time.Hour).Truncate(time.Hour)), duration, horizon)
+}
+
+func testRecurringMaintenanceOccurrence(id string, starts string, ends string) RecurringMaintenanceIssueModel {
+	occurrence := RecurringMaintenanceIssueModel{
+		Id:                  types.StringNull(),
+		StartsAt:            types.StringValue(starts),
+		EndsAt:              types.StringValue(ends),
+		NotifySubscribersAt: types.StringNull(),
+	}
+
+	if id != "" {
+		occurrence.Id = types.StringValue(id)
+	}
+
+	return occurrence
+}
+
+func TestRecurringMaintenanceDesiredIssues(t *testing.T) {
+	data := RecurringMaintenanceResourceModel{
+		Cron:         types.StringValue("0 2 * * 2"),
+		RRule:        types.StringNull(),
+		StartsAt:     types.StringValue("2025-01-10T00:00:00Z"),
+		TimeZone:     types.StringValue("UTC"),
+		Duration:     types.StringValue("90m"),
+		NotifyBefore: types.StringValue("24h"),
+		Horizon:      types.Int64Value(2),
+	}
+
+	var diags diag.Diagnostics
+
+	desired := data.desiredIssues(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	actual := []string{}
+
+	for _, occurrence := range desired {
+		actual = append(actual, occurrence.StartsAt.ValueString()+"/"+occurrence.EndsAt.ValueString()+"/"+occurrence.NotifySubscribersAt.ValueString())
+	}
+
+	expected := "[2025-01-14T02:00:00Z/2025-01-14T03:30:00Z/2025-01-13T02:00:00Z 2025-01-21T02:00:00Z/2025-01-21T03:30:00Z/2025-01-20T02:00:00Z]"
+
+	if fmt.Sprint(actual) != expected {
+		t.Errorf("expected %s, got %v", expected, actual)
+	}
+}
+
+func TestReconcileRecurringMaintenance(t *testing.T) {
+	now := time.Date(2025, time.January, 14, 2, 30, 0, 0, time.UTC)
+
+	current := []RecurringMaintenanceIssueModel{
+		// In progress, and no longer desired: kept.
+		testRecurringMaintenanceOccurrence("a", "2025-01-14T02:00:00Z", "2025-01-14T04:00:00Z"),
+		// Still desired: kept.
+		testRecurringMaintenanceOccurrence("b", "2025-01-21T02:00:00Z", "2025-01-21T03:00:00Z"),
+		// No longer desired: cancelled.
+		testRecurringMaintenanceOccurrence("c", "2025-01-28T02:00:00Z", "2025-01-28T04:00:00Z"),
+	}
+
+	desired := []RecurringMaintenanceIssueModel{
+		testRecurringMaintenanceOccurrence("", "2025-01-21T02:00:00Z", "2025-01-21T03:00:00Z"),
+		testRecurringMaintenanceOccurrence("", "2025-01-28T02:00:00Z", "2025-01-28T03:00:00Z"),
+	}
+
+	keep, cancel, create := reconcileRecurringMaintenance(current, desired, now)
+
+	ids := func(occurrences []RecurringMaintenanceIssueModel) string {
+		result := []string{}
+
+		for _, occurrence := range occurrences {
+			result = append(result, occurrence.Id.ValueString()+"@"+occurrence.StartsAt.ValueString())
+		}
+
+		return fmt.Sprint(result)
+	}
+
+	for _, c := range []struct {
+		name     string
+		actual   []RecurringMaintenanceIssueModel
+		expected string
+	}{
+		{"keep", keep, "[a@2025-01-14T02:00:00Z b@2025-01-21T02:00:00Z]"},
+		{"cancel", cancel, "[c@2025-01-28T02:00:00Z]"},
+		{"create", create, "[@2025-01-28T02:00:00Z]"},
+	} {
+		if actual := ids(c.actual); actual != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.name, c.expected, actual)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
@@ -52,6 +54,9 @@ func issueTemplateApplicationSchema() schema.SingleNestedAttribute {
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					validators.TranslationKeys(),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
@@ -80,7 +85,7 @@ func issueTemplateApplicationSchema() schema.SingleNestedAttribute {
 					Attributes: map[string]schema.Attribute{
 						"string":      schema.StringAttribute{Optional: true},
 						"number":      schema.NumberAttribute{Optional: true},
-						"i18n_string": schema.MapAttribute{Optional: true, ElementType: types.StringType},
+						"i18n_string": schema.MapAttribute{Optional: true, ElementType: types.StringType, Validators: []validator.Map{validators.TranslationKeys()}},
 						"datetime":    schema.StringAttribute{Optional: true},
 					},
 					Validators: []validator.Object{
@@ -109,6 +114,9 @@ func issueTemplateApplicationIssueSchema() schema.SingleNestedAttribute {
 		Optional:            true,
 		Computed:            true,
 		ElementType:         types.StringType,
+		Validators: []validator.Map{
+			validators.TranslationKeys(),
+		},
 		PlanModifiers: []planmodifier.Map{
 			mapplanmodifier.UseStateForUnknown(),
 		},
@@ -437,6 +445,59 @@ func watchdogServiceTypeChanged(plan types.Object, state types.Object) bool {
 	return false
 }
 
+// watchdogServiceCredentials names the credential attribute of each Watchdog
+// service type which is never returned by the Hund API.
+var watchdogServiceCredentials = map[string]string{
+	"updown":      "monitor_api_key",
+	"pingdom":     "api_token",
+	"uptimerobot": "monitor_api_key",
+}
+
+// validateWatchdogServiceCredentials requires the credentials of a Watchdog
+// service when it is being created, either along with its Component, or by
+// changing the service type. Existing services may omit their credentials.
+func validateWatchdogServiceCredentials(servicePath path.Path, plan types.Object, state types.Object, diags *diag.Diagnostics) {
+	if plan.IsNull() || plan.IsUnknown() {
+		return
+	}
+
+	if !state.IsNull() && !state.IsUnknown() && !watchdogServiceTypeChanged(plan, state) {
+		return
+	}
+
+	for serviceType, attribute := range watchdogServiceCredentials {
+		service, ok := plan.Attributes()[serviceType].(types.Object)
+		if !ok || service.IsNull() || service.IsUnknown() {
+			continue
+		}
+
+		if service.Attributes()[attribute].IsNull() {
+			diags.AddAttributeError(
+				servicePath.AtName(serviceType).AtName(attribute),
+				"Missing Watchdog Service Credential",
+				"The "+attribute+" attribute is required when creating a "+serviceType+
+					" Watchdog service.",
+			)
+		}
+	}
+}
+
+// importedI18nAttributes reduces a translated attribute pair read during
+// import to the single attribute that would be configured: the original
+// string when there are no other translations, or the translations otherwise.
+func importedI18nAttributes(original *types.String, translations *types.Map) {
+	if translations.IsNull() || translations.IsUnknown() {
+		return
+	}
+
+	// A lone translation is represented by its locale, and the `original` key.
+	if !original.IsNull() && len(translations.Elements()) <= 2 {
+		*translations = types.MapNull(types.StringType)
+	} else {
+		*original = types.StringNull()
+	}
+}
+
 func translationOriginalFieldMarkdownDescription(baseDesc string) string {
 	return strings.TrimSuffix(baseDesc, ".") + ", in the default translation."
 }
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer emits the spans of the provider. It does nothing unless StartTracing
+// has enabled tracing.
+var tracer = otel.Tracer("github.com/hundio/terraform-provider-hund")
+
+// tracingParent is the span given by the TRACEPARENT environment variable, if
+// any, under which resource operations are traced.
+var tracingParent trace.SpanContext
+
+// StartTracing exports the spans of the provider over OTLP, when an endpoint is
+// given by the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT
+// environment variables. The exporter is otherwise configured by the standard
+// OTEL_EXPORTER_OTLP_* environment variables. The returned function flushes
+// any pending spans, and must be called before the provider exits.
+func StartTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	shutdown := func(context.Context) error { return nil }
+
+	if !tracingEnabled() {
+		return shutdown, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+
+	switch protocol {
+	case "", "http/protobuf":
+		exporter, err = otlptracehttp.New(ctx)
+	case "grpc":
+		exporter, err = otlptracegrpc.New(ctx)
+	default:
+		return shutdown, fmt.Errorf("unsupported OTLP protocol %q: must be one of grpc, http/protobuf", protocol)
+	}
+
+	if err != nil {
+		return shutdown, err
+	}
+
+	resource, err := sdkresource.New(ctx,
+		sdkresource.WithAttributes(
+			semconv.ServiceName("terraform-provider-hund"),
+			semconv.ServiceVersion(version),
+		),
+		sdkresource.WithFromEnv(),
+		sdkresource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return shutdown, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+
+	tracingParent = trace.SpanContextFromContext(propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{
+		"traceparent": os.Getenv("TRACEPARENT"),
+		"tracestate":  os.Getenv("TRACESTATE"),
+	}))
+
+	return tracerProvider.Shutdown, nil
+}
+
+func tracingEnabled() bool {
+	if os.Getenv("OTEL_SDK_DISABLED") == "true" || os.Getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return false
+	}
+
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// startResourceSpan starts the span of a CRUD operation (e.g. "Create") on a
+// resource of the given type. The span must be ended with endResourceSpan.
+func startResourceSpan(ctx context.Context, typeName string, operation string) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() && tracingParent.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, tracingParent)
+	}
+
+	return tracer.Start(ctx, operation+" "+typeName, trace.WithAttributes(
+		attribute.String("hund.resource.type", typeName),
+		attribute.String("hund.resource.operation", operation),
+	))
+}
+
+// endResourceSpan ends the span of a CRUD operation, recording the ID of the
+// object in the given state, and the first error diagnostic, if any.
+func endResourceSpan(ctx context.Context, span trace.Span, state *tfsdk.State, diags *diag.Diagnostics) {
+	defer span.End()
+
+	var id types.String
+
+	if !state.Raw.IsNull() {
+		_ = state.GetAttribute(ctx, path.Root("id"), &id)
+	}
+
+	if !id.IsNull() && !id.IsUnknown() {
+		span.SetAttributes(attribute.String("hund.object.id", id.ValueString()))
+	}
+
+	if errors := diags.Errors(); len(errors) > 0 {
+		span.SetStatus(codes.Error, errors[0].Summary())
+	}
+}
+
+// tracingTransport emits a span for every request to the Hund API. It wraps
+// the retryable transport, so that the span of a request covers its retries,
+// which are counted by recordResend.
+type tracingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := tracer.Start(req.Context(), req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Hostname()),
+			semconv.URLPath(req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	rsp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(rsp.StatusCode))
+
+	if rsp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(rsp.StatusCode))
+	}
+
+	return rsp, nil
+}
+
+// recordResend is a retryablehttp.RequestLogHook which counts the retries of
+// a request on its span.
+func recordResend(_ retryablehttp.Logger, req *http.Request, attempt int) {
+	if attempt > 0 {
+		trace.SpanFromContext(req.Context()).SetAttributes(semconv.HTTPRequestResendCount(attempt))
+	}
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/hundio/terraform-provider-hund/internal/hundtest"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	server := hundtest.NewServer()
+	defer server.Close()
+
+	client, err := newProviderClient("test", server.Endpoint(), "hundtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := tfsdk.State{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{Computed: true},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "missing"),
+		}),
+	}
+
+	var diags diag.Diagnostics
+
+	ctx, span := startResourceSpan(context.Background(), "hund_group", "Read")
+
+	rsp, err := client.RetrieveAGroup(ctx, "missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = rsp.Body.Close()
+
+	diags.AddError("Unable to Read Hund Group", "not found")
+
+	recordResend(nil, rsp.Request, 2)
+
+	endResourceSpan(ctx, span, &state, &diags)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected a resource span and an API span, got %d", len(spans))
+	}
+
+	request, operation := spans[0], spans[1]
+
+	if request.Parent().SpanID() != operation.SpanContext().SpanID() {
+		t.Error("expected the API span to be a child of the resource span")
+	}
+
+	expectAttributes(t, request.Name(), request.Attributes(), map[attribute.Key]attribute.Value{
+		"http.request.method":       attribute.StringValue(http.MethodGet),
+		"url.path":                  attribute.StringValue("/api/v1/groups/missing"),
+		"http.response.status_code": attribute.IntValue(404),
+	})
+
+	expectAttributes(t, operation.Name(), operation.Attributes(), map[attribute.Key]attribute.Value{
+		"hund.resource.type":      attribute.StringValue("hund_group"),
+		"hund.resource.operation": attribute.StringValue("Read"),
+		"hund.object.id":          attribute.StringValue("missing"),
+	})
+
+	if operation.Name() != "Read hund_group" || operation.Status().Code != codes.Error || operation.Status().Description != "Unable to Read Hund Group" {
+		t.Errorf("unexpected resource span %q: %v", operation.Name(), operation.Status())
+	}
+}
+
+func TestRecordResend(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	ctx, span := tracerProvider.Tracer("test").Start(context.Background(), "GET")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.hund.io/api/v1/groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recordResend(nil, req, 0)
+	recordResend(nil, req, 1)
+	span.End()
+
+	expectAttributes(t, "GET", recorder.Ended()[0].Attributes(), map[attribute.Key]attribute.Value{
+		"http.request.resend_count": attribute.IntValue(1),
+	})
+}
+
+func expectAttributes(t *testing.T, name string, attributes []attribute.KeyValue, expected map[attribute.Key]attribute.Value) {
+	t.Helper()
+
+	actual := map[attribute.Key]attribute.Value{}
+
+	for _, kv := range attributes {
+		actual[kv.Key] = kv.Value
+	}
+
+	for key, value := range expected {
+		if actual[key] != value {
+			t.Errorf("span %q: expected %s to be %s, got %s", name, key, value.Emit(), actual[key].Emit())
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hundio/terraform-provider-hund/internal/validators"
+)
+
+// isTranslationsAttribute reports whether the named attribute holds a map of
+// translations keyed by locale.
+func isTranslationsAttribute(name string) bool {
+	return strings.HasSuffix(name, "_translations") || name == "i18n_string"
+}
+
+// checkTranslationLocales compares every translations map in the given
+// configuration against the locales of the status page, as given in the
+// provider configuration. Translations into other locales are errors, and
+// missing translations are warnings. Nothing is checked when no locales are
+// configured.
+func checkTranslationLocales(ctx context.Context, locales []string, config tfsdk.Config, diags *diag.Diagnostics) {
+	if len(locales) == 0 || config.Raw.IsNull() {
+		return
+	}
+
+	err := tftypes.Walk(config.Raw, func(attributePath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		steps := attributePath.Steps()
+
+		if len(steps) == 0 {
+			return true, nil
+		}
+
+		name, ok := steps[len(steps)-1].(tftypes.AttributeName)
+
+		if !ok || !isTranslationsAttribute(string(name)) || !value.Type().Is(tftypes.Map{}) {
+			return true, nil
+		}
+
+		if !value.IsKnown() || value.IsNull() {
+			return false, nil
+		}
+
+		translations := map[string]tftypes.Value{}
+		if err := value.As(&translations); err != nil {
+			return false, err
+		}
+
+		attrPath, ok := toAttributePath(attributePath)
+
+		for key, translation := range translations {
+			tag := key
+
+			if key == validators.OriginalTranslationKey {
+				if !translation.IsKnown() || translation.IsNull() || translation.As(&tag) != nil {
+					continue
+				}
+			}
+
+			if slices.Contains(locales, tag) {
+				continue
+			}
+
+			detail := "The locale \"" + tag + "\" is not one of the locales of the status page, given in the provider `locales`: " + strings.Join(locales, ", ") + "."
+
+			if ok {
+				diags.AddAttributeError(attrPath.AtMapKey(key), "Unknown Translation Locale", detail)
+			} else {
+				diags.AddError("Unknown Translation Locale", detail)
+			}
+		}
+
+		missing := []string{}
+
+		for _, locale := range locales {
+			if _, present := translations[locale]; !present {
+				missing = append(missing, locale)
+			}
+		}
+
+		if len(missing) > 0 {
+			detail := "No translation is given for the following locales of the status page, which will fall back to the page's default language: " + strings.Join(missing, ", ") + "."
+
+			if ok {
+				diags.AddAttributeWarning(attrPath, "Missing Translations", detail)
+			} else {
+				diags.AddWarning("Missing Translations", detail)
+			}
+		}
+
+		return false, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Check Translation Locales",
+			err.Error(),
+		)
+	}
+}
+
+// toAttributePath converts a tftypes.AttributePath into a path.Path, reporting
+// false if it traverses a set, which cannot be expressed without its value.
+func toAttributePath(attributePath *tftypes.AttributePath) (path.Path, bool) {
+	result := path.Empty()
+
+	for _, step := range attributePath.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			result = result.AtName(string(step))
+		case tftypes.ElementKeyString:
+			result = result.AtMapKey(string(step))
+		case tftypes.ElementKeyInt:
+			result = result.AtListIndex(int(step))
+		default:
+			return result, false
+		}
+	}
+
+	return result, true
+}
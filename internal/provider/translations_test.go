@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hundio/terraform-provider-hund/internal/validators"
+)
+
+func testTranslationDiagnostics(diags diag.Diagnostics) string {
+	result := []string{}
+
+	for _, d := range diags {
+		entry := d.Severity().String() + ":" + d.Summary()
+
+		if d, ok := d.(diag.DiagnosticWithPath); ok {
+			entry += "@" + d.Path().String()
+		}
+
+		result = append(result, entry)
+	}
+
+	sort.Strings(result)
+
+	return strings.Join(result, ", ")
+}
+
+func TestTranslationKeysValidator(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		translations map[string]string
+		expected     string
+	}{
+		{map[string]string{"original": "en", "en": "Core", "de": "Kern", "zh-Hant-TW": "核心"}, ""},
+		{map[string]string{"original": "en_US", "en_US": "Core"}, "Error:Invalid Translation Locale@name_translations[\"en_US\"], Error:Invalid Translation Locale@name_translations[\"original\"]"},
+		{map[string]string{"EN": "Core"}, "Error:Invalid Translation Locale@name_translations[\"EN\"]"},
+	}
+
+	for _, c := range cases {
+		value, diags := types.MapValueFrom(ctx, types.StringType, c.translations)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		resp := validator.MapResponse{}
+		validators.TranslationKeys().ValidateMap(ctx, validator.MapRequest{Path: path.Root("name_translations"), ConfigValue: value}, &resp)
+
+		if actual := testTranslationDiagnostics(resp.Diagnostics); actual != c.expected {
+			t.Errorf("%v: expected %q, got %q", c.translations, c.expected, actual)
+		}
+	}
+
+	resp := validator.MapResponse{}
+	value, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"en_us": "Core"})
+	validators.TranslationKeys().ValidateMap(ctx, validator.MapRequest{Path: path.Root("name_translations"), ConfigValue: value}, &resp)
+
+	if len(resp.Diagnostics) != 1 || !strings.Contains(resp.Diagnostics[0].Detail(), `Did you mean "en-US"?`) {
+		t.Errorf("expected a suggestion of en-US, got %v", resp.Diagnostics)
+	}
+}
+
+func TestCheckTranslationLocales(t *testing.T) {
+	ctx := context.Background()
+
+	r := NewGroupResource()
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	mapType := tftypes.Map{ElementType: tftypes.String}
+
+	config := func(translations map[string]string) tfsdk.Config {
+		elements := map[string]tftypes.Value{}
+
+		for key, value := range translations {
+			elements[key] = tftypes.NewValue(tftypes.String, value)
+		}
+
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: testObjectValue(objectType, map[string]tftypes.Value{
+			"name_translations": tftypes.NewValue(mapType, elements),
+		})}
+	}
+
+	cases := []struct {
+		locales      []string
+		translations map[string]string
+		expected     string
+	}{
+		{nil, map[string]string{"fr": "Noyau"}, ""},
+		{[]string{"en", "de"}, map[string]string{"original": "en", "en": "Core", "de": "Kern"}, ""},
+		{[]string{"en", "de"}, map[string]string{"original": "en", "en": "Core"}, "Warning:Missing Translations@name_translations"},
+		{[]string{"en", "de"}, map[string]string{"original": "en", "en": "Core", "de": "Kern", "de-AT": "Kern"}, "Error:Unknown Translation Locale@name_translations[\"de-AT\"]"},
+		{[]string{"en", "de"}, map[string]string{"original": "fr", "en": "Core", "de": "Kern"}, "Error:Unknown Translation Locale@name_translations[\"original\"]"},
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+
+		checkTranslationLocales(ctx, c.locales, config(c.translations), &diags)
+
+		if actual := testTranslationDiagnostics(diags); actual != c.expected {
+			t.Errorf("%v with %v: expected %q, got %q", c.translations, c.locales, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	hundApiV1 "github.com/hundio/terraform-provider-hund/internal/hund_api_v1"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &UnixToRFC3339Function{}
+
+func NewUnixToRFC3339Function() function.Function {
+	return &UnixToRFC3339Function{}
+}
+
+// UnixToRFC3339Function defines the function implementation.
+type UnixToRFC3339Function struct{}
+
+func (f *UnixToRFC3339Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "unix_to_rfc3339"
+}
+
+func (f *UnixToRFC3339Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert a UNIX timestamp to RFC3339",
+		MarkdownDescription: "Converts a UNIX timestamp (in seconds) to an RFC3339 timestamp in UTC, exactly as the provider records timestamps such as `began_at` and `schedule.starts_at` in state.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "timestamp",
+				MarkdownDescription: "The number of seconds since the UNIX epoch.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *UnixToRFC3339Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, hundApiV1.ToStringTimestamp(timestamp))
+}
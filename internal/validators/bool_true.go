@@ -0,0 +1,38 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// BoolTrue validates that a boolean is true when given, for attributes which
+// only select a behavior by being set, so that false would silently do
+// nothing.
+func BoolTrue() boolTrue {
+	return boolTrue{}
+}
+
+type boolTrue struct{}
+
+var _ validator.Bool = &boolTrue{}
+
+func (v boolTrue) Description(ctx context.Context) string {
+	return "Validate boolean is true, if given."
+}
+
+func (v boolTrue) MarkdownDescription(ctx context.Context) string {
+	return "Validate boolean is `true`, if given."
+}
+
+func (v boolTrue) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		"This attribute may only be set to true. To choose another behavior, set a different attribute instead.",
+	)
+}
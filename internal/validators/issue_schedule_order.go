@@ -0,0 +1,104 @@
+package validators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func IssueScheduleOrder() issueScheduleOrder {
+	return issueScheduleOrder{}
+}
+
+type issueScheduleOrder struct{}
+
+var _ resource.ConfigValidator = &issueScheduleOrder{}
+
+func (v issueScheduleOrder) Description(ctx context.Context) string {
+	return "Validate an Issue's timestamps are in order: notify_subscribers_at < starts_at < ends_at for schedules, and began_at < ended_at otherwise."
+}
+
+func (v issueScheduleOrder) MarkdownDescription(ctx context.Context) string {
+	return "Validate an Issue's timestamps are in order: `notify_subscribers_at` < `starts_at` < `ends_at` for schedules, and `began_at` < `ended_at` otherwise."
+}
+
+func (v issueScheduleOrder) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	schedulePath := path.Root("schedule")
+
+	var notifySubscribersAt, startsAt, endsAt, beganAt, endedAt types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, schedulePath.AtName("notify_subscribers_at"), &notifySubscribersAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, schedulePath.AtName("starts_at"), &startsAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, schedulePath.AtName("ends_at"), &endsAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("began_at"), &beganAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ended_at"), &endedAt)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ordered := []struct {
+		before, after         types.String
+		beforePath, afterPath path.Path
+		detail                string
+	}{
+		{
+			notifySubscribersAt, startsAt,
+			schedulePath.AtName("notify_subscribers_at"), schedulePath.AtName("starts_at"),
+			"Subscribers must be notified of a scheduled Issue before it starts.",
+		},
+		{
+			startsAt, endsAt,
+			schedulePath.AtName("starts_at"), schedulePath.AtName("ends_at"),
+			"A scheduled Issue must end after it starts.",
+		},
+		{
+			// The schedule determines when the Issue begins, so an Issue may
+			// only be ended after its scheduled start.
+			startsAt, endedAt,
+			schedulePath.AtName("starts_at"), path.Root("ended_at"),
+			"A scheduled Issue cannot end before its schedule starts.",
+		},
+		{
+			beganAt, endedAt,
+			path.Root("began_at"), path.Root("ended_at"),
+			"An Issue must end after it begins.",
+		},
+	}
+
+	for _, o := range ordered {
+		before, ok := parseTimestamp(o.before)
+		if !ok {
+			continue
+		}
+
+		after, ok := parseTimestamp(o.after)
+		if !ok {
+			continue
+		}
+
+		if !before.Before(after) {
+			resp.Diagnostics.AddAttributeError(
+				o.afterPath,
+				"Issue Timestamps Out of Order",
+				o.detail+" Expected "+o.beforePath.String()+" ("+o.before.ValueString()+") to precede "+
+					o.afterPath.String()+" ("+o.after.ValueString()+").",
+			)
+		}
+	}
+}
+
+// parseTimestamp parses a known RFC3339 timestamp. Malformed timestamps are
+// reported when the resource is applied.
+func parseTimestamp(value types.String) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+
+	return t, err == nil
+}
@@ -0,0 +1,128 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OriginalTranslationKey is the key of a translations map giving the locale of
+// the non-translated version of the attribute.
+const OriginalTranslationKey = "original"
+
+// localeTagPattern matches the language tags used by Hund: a language,
+// optionally followed by a script and/or region (e.g. "en", "en-US",
+// "zh-Hant-TW").
+var localeTagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z][a-z]{3})?(-([A-Z]{2}|[0-9]{3}))?$`)
+
+// IsLocaleTag reports whether the given string is a well-formed language tag.
+func IsLocaleTag(tag string) bool {
+	return localeTagPattern.MatchString(tag)
+}
+
+// suggestLocaleTag returns a well-formed spelling of a malformed language tag,
+// such as "en-US" for "en_us", if there is one.
+func suggestLocaleTag(tag string) (string, bool) {
+	parts := strings.Split(strings.ReplaceAll(tag, "_", "-"), "-")
+
+	for i, part := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(part)
+		case len(part) == 4:
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		default:
+			parts[i] = strings.ToUpper(part)
+		}
+	}
+
+	suggestion := strings.Join(parts, "-")
+
+	return suggestion, suggestion != tag && IsLocaleTag(suggestion)
+}
+
+func localeTagDetail(tag string) string {
+	detail := "Expected a language tag such as \"en\" or \"en-US\", got: \"" + tag + "\"."
+
+	if suggestion, ok := suggestLocaleTag(tag); ok {
+		detail += " Did you mean \"" + suggestion + "\"?"
+	}
+
+	return detail
+}
+
+func LocaleTag() localeTag {
+	return localeTag{}
+}
+
+type localeTag struct{}
+
+var _ validator.String = &localeTag{}
+
+func (v localeTag) Description(ctx context.Context) string {
+	return "Validate a string is a well-formed language tag."
+}
+
+func (v localeTag) MarkdownDescription(ctx context.Context) string {
+	return "Validate a string is a well-formed language tag."
+}
+
+func (v localeTag) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !IsLocaleTag(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Locale",
+			localeTagDetail(req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+func TranslationKeys() translationKeys {
+	return translationKeys{}
+}
+
+type translationKeys struct{}
+
+var _ validator.Map = &translationKeys{}
+
+func (v translationKeys) Description(ctx context.Context) string {
+	return "Validate the keys of a translations map are well-formed language tags, or `original` naming one."
+}
+
+func (v translationKeys) MarkdownDescription(ctx context.Context) string {
+	return "Validate the keys of a translations map are well-formed language tags, or `original` naming one."
+}
+
+func (v translationKeys) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for key, value := range req.ConfigValue.Elements() {
+		tag := key
+
+		if key == OriginalTranslationKey {
+			original, ok := value.(types.String)
+			if !ok || original.IsNull() || original.IsUnknown() {
+				continue
+			}
+
+			tag = original.ValueString()
+		}
+
+		if !IsLocaleTag(tag) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Translation Locale",
+				localeTagDetail(tag),
+			)
+		}
+	}
+}
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -30,6 +33,10 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(export(os.Args[2:]))
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -40,9 +47,79 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	ctx := context.Background()
+
+	shutdownTracing, err := provider.StartTracing(ctx, version)
+	if err != nil {
+		log.Printf("[WARN] Unable to start tracing: %s", err)
+	}
+
+	err = providerserver.Serve(ctx, provider.New(version), opts)
+
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("[WARN] Unable to flush traces: %s", shutdownErr)
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 }
+
+// export writes Terraform configuration for the status page given by the
+// HUND_DOMAIN and HUND_KEY environment variables, returning the exit code.
+func export(args []string) int {
+	var issues bool
+	var out string
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.BoolVar(&issues, "issues", false, "also export every unresolved Issue")
+	flags.StringVar(&out, "out", "", "write the configuration to the given file, rather than stdout")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [options]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes hund_* resources and import blocks for the status page given by the")
+		fmt.Fprintln(flags.Output(), "HUND_DOMAIN and HUND_KEY environment variables.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	opts := provider.ExportOptions{
+		Domain:        os.Getenv("HUND_DOMAIN"),
+		Key:           os.Getenv("HUND_KEY"),
+		IncludeIssues: issues,
+	}
+
+	if opts.Domain == "" || opts.Key == "" {
+		fmt.Fprintln(os.Stderr, "Error: the HUND_DOMAIN and HUND_KEY environment variables must be set.")
+		return 1
+	}
+
+	var buf bytes.Buffer
+
+	diags := provider.Export(context.Background(), version, &buf, opts)
+
+	for _, d := range diags {
+		fmt.Fprintf(os.Stderr, "%s: %s\n\n%s\n\n", d.Severity(), d.Summary(), d.Detail())
+	}
+
+	if diags.HasError() {
+		return 1
+	}
+
+	if out == "" {
+		_, err := os.Stdout.Write(buf.Bytes())
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error: "+err.Error())
+			return 1
+		}
+
+		return 0
+	}
+
+	if err := os.WriteFile(out, buf.Bytes(), 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "Error: "+err.Error())
+		return 1
+	}
+
+	return 0
+}